@@ -0,0 +1,24 @@
+data "awsteam_account" "example" {
+  name = "My-aws-account"
+}
+
+// The account id and name always come from the same account
+resource "awsteam_eligibility_user" "example" {
+  user_name         = "my-user@contoso.com"
+  user_id           = "d78686b5-bb78-471c-8b2f-817e70e3158b"
+  approval_required = true
+  duration          = 5
+  accounts = [
+    {
+      account_id   = data.awsteam_account.example.id
+      account_name = data.awsteam_account.example.name
+    }
+  ]
+  ous = []
+  permissions = [
+    {
+      permission_arn  = "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3"
+      permission_name = "elevated-permission"
+    }
+  ]
+}
@@ -1,21 +1,27 @@
 data "awsteam_accounts" "all" {}
 
-// Build a map of Account Name to Account id
-locals {
-  acount_name_to_id_map = { for account in data.awsteam_accounts.all.accounts : account.name => account.id }
-}
-
 // How to access the account id from the account name mapping
 output "test_account_id" {
-  value = local.account_name_to_id_map["my-test-aws-account-name"]
+  value = data.awsteam_accounts.all.by_name["my-test-aws-account-name"]
 }
 
 // Access all account names from the data source
 output "account_names" {
-  value = data.awsteam_accounts.accounts.*.name
+  value = data.awsteam_accounts.all.accounts[*].name
 }
 
 // Access all account ids from the data source
 output "account_ids" {
-  value = data.awsteam_accounts.accounts.*.id
+  value = data.awsteam_accounts.all.accounts[*].id
+}
+
+// Only return accounts with names starting with "prod-" in an OU or any of its nested OUs
+data "awsteam_accounts" "prod" {
+  name_regex = "^prod-"
+  ou_id      = "ou-cxt3-2782ty5g"
+}
+
+// Only return the listed accounts
+data "awsteam_accounts" "selected" {
+  ids = ["123456789012", "210987654321"]
 }
@@ -0,0 +1,8 @@
+// Read the approvers policy of an account, or of an OU by its OU id
+data "awsteam_approvers" "example" {
+  id = "123456789012"
+}
+
+output "approver_group_names" {
+  value = data.awsteam_approvers.example.approvers
+}
@@ -0,0 +1,11 @@
+data "awsteam_approvers_list" "all" {}
+
+// Only return approvers policies for OUs
+data "awsteam_approvers_list" "ous" {
+  type = "OU"
+}
+
+// Build a map of account or OU id to approver group ids
+locals {
+  approver_group_ids = { for policy in data.awsteam_approvers_list.all.approvers_list : policy.id => policy.group_ids }
+}
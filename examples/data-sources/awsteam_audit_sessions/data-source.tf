@@ -0,0 +1,13 @@
+// Sessions that ran during the first quarter
+data "awsteam_audit_sessions" "q1" {
+  start_time = "2024-01-01T00:00:00Z"
+  end_time   = "2024-03-31T23:59:59Z"
+}
+
+output "q1_sessions" {
+  value = [for session in data.awsteam_audit_sessions.q1.sessions : "${session.username} assumed ${session.role} in ${session.account_id} for ${session.duration_minutes} minutes"]
+}
+
+output "q1_revoked_sessions" {
+  value = [for session in data.awsteam_audit_sessions.q1.sessions : session.request_id if session.revoked]
+}
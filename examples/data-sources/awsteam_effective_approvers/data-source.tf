@@ -0,0 +1,25 @@
+data "awsteam_accounts" "prod" {
+  name_regex = "^prod-"
+}
+
+data "awsteam_effective_approvers" "prod" {
+  for_each = data.awsteam_accounts.prod.by_name
+
+  account_id = each.value
+}
+
+// Fail the plan if any production account is left without approvers
+check "prod_accounts_have_approvers" {
+  assert {
+    condition     = alltrue([for approvers in data.awsteam_effective_approvers.prod : length(approvers.group_ids) > 0])
+    error_message = "Every production account must have at least one approver group."
+  }
+}
+
+// Show where each approver group of an account comes from
+output "prod_approver_sources" {
+  value = {
+    for name, approvers in data.awsteam_effective_approvers.prod :
+    name => [for approver in approvers.approvers : "${approver.group_name} (${approver.source_type} ${approver.source_name})"]
+  }
+}
@@ -0,0 +1,17 @@
+data "awsteam_effective_eligibility" "example" {
+  user_id = "d78686b5-bb78-471c-8b2f-817e70e3158b"
+}
+
+// One line per account and permission set the user can request, for access reviews
+output "access_review" {
+  value = [
+    for eligibility in data.awsteam_effective_eligibility.example.eligibilities :
+    format("%s (%s): %s, up to %d hours%s",
+      eligibility.account_name,
+      eligibility.account_id,
+      eligibility.permission_name,
+      eligibility.max_duration,
+      eligibility.approval_required ? ", approval required" : ""
+    )
+  ]
+}
@@ -0,0 +1,22 @@
+data "awsteam_permission_sets" "admin" {
+  name_regex = "^AdministratorAccess$"
+}
+
+data "awsteam_account" "prod" {
+  name = "my-prod-account"
+}
+
+// Who is eligible for AdministratorAccess on the prod account
+data "awsteam_eligibilities" "prod_admins" {
+  account_id     = data.awsteam_account.prod.id
+  permission_arn = data.awsteam_permission_sets.admin.permission_sets[0].arn
+}
+
+output "prod_admin_eligibilities" {
+  value = [for eligibility in data.awsteam_eligibilities.prod_admins.eligibilities : "${eligibility.type}: ${eligibility.name}"]
+}
+
+// All group eligibility policies
+data "awsteam_eligibilities" "groups" {
+  type = "Group"
+}
@@ -0,0 +1,20 @@
+// Look up an eligibility policy by the id of its user or group
+data "awsteam_eligibility" "by_id" {
+  id = "d78686b5-bb78-471c-8b2f-817e70e3158b"
+}
+
+// Look up an eligibility policy by the name of its user or group
+data "awsteam_eligibility" "developers" {
+  name = "my-group@contoso.com"
+  type = "Group"
+}
+
+// Require approval for exactly the accounts the group is eligible for
+resource "awsteam_approvers_account" "developers" {
+  for_each = { for account in data.awsteam_eligibility.developers.accounts : account.account_id => account }
+
+  account_id   = each.value.account_id
+  account_name = each.value.account_name
+  approvers    = ["my-group-approvers@contoso.com"]
+  group_ids    = ["0c1e2f3a-4b5c-6d7e-8f90-a1b2c3d4e5f6"]
+}
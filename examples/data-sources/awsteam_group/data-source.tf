@@ -0,0 +1,24 @@
+data "awsteam_group" "developers" {
+  display_name = "developers"
+}
+
+// The group name and id always come from the same group
+resource "awsteam_eligibility_group" "developers" {
+  group_name        = data.awsteam_group.developers.display_name
+  group_id          = data.awsteam_group.developers.id
+  approval_required = true
+  duration          = 5
+  accounts = [
+    {
+      account_id   = "123456789012"
+      account_name = "My-aws-account"
+    }
+  ]
+  ous = []
+  permissions = [
+    {
+      permission_arn  = "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3"
+      permission_name = "elevated-permission"
+    }
+  ]
+}
@@ -0,0 +1,12 @@
+// Only return groups with display names starting with "approvers-"
+data "awsteam_groups" "approvers" {
+  name_regex = "^approvers-"
+}
+
+// Approver names and group ids always come from the same groups
+resource "awsteam_approvers_account" "example" {
+  account_id   = "123456789012"
+  account_name = "my-account"
+  approvers    = keys(data.awsteam_groups.approvers.by_display_name)
+  group_ids    = values(data.awsteam_groups.approvers.by_display_name)
+}
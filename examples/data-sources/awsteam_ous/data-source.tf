@@ -0,0 +1,19 @@
+data "awsteam_ous" "all" {}
+
+// Names are only unique among siblings, so look OUs up by parent and name
+locals {
+  root_id   = one([for ou in data.awsteam_ous.all.ous : ou.id if ou.parent_id == null])
+  workloads = one([for ou in data.awsteam_ous.all.ous : ou if ou.parent_id == local.root_id && ou.name == "Workloads"])
+}
+
+resource "awsteam_approvers_ou" "example" {
+  ou_id     = local.workloads.id
+  ou_name   = local.workloads.name
+  approvers = ["my-group@contoso.com"]
+  group_ids = ["d78686b5-bb78-471c-8b2f-817e70e3158b"]
+}
+
+// Access the nested organization hierarchy
+output "top_level_ou_names" {
+  value = [for ou in jsondecode(data.awsteam_ous.all.tree)[0].children : ou.name]
+}
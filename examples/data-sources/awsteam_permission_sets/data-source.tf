@@ -0,0 +1,32 @@
+data "awsteam_permission_sets" "all" {}
+
+// Only return permission sets with names starting with "elevated-"
+data "awsteam_permission_sets" "elevated" {
+  name_regex = "^elevated-"
+}
+
+// Keep the eligibility policy permissions in sync with the permission sets
+resource "awsteam_eligibility_group" "example" {
+  group_name        = "my-group@contoso.com"
+  group_id          = "d78686b5-bb78-471c-8b2f-817e70e3158b"
+  approval_required = true
+  duration          = 5
+  accounts = [
+    {
+      account_id   = "123456789012"
+      account_name = "My-aws-account"
+    }
+  ]
+  ous = []
+  permissions = [
+    for permission_set in data.awsteam_permission_sets.elevated.permission_sets : {
+      permission_arn  = permission_set.arn
+      permission_name = permission_set.name
+    }
+  ]
+}
+
+// Access all permission set names from the data source
+output "permission_set_names" {
+  value = data.awsteam_permission_sets.all.permission_sets[*].name
+}
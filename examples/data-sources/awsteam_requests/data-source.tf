@@ -0,0 +1,19 @@
+data "awsteam_account" "prod" {
+  name = "my-prod-account"
+}
+
+// Elevated access to the prod account during the last audit period
+data "awsteam_requests" "prod" {
+  account_id        = data.awsteam_account.prod.id
+  start_time_after  = "2024-01-01T00:00:00Z"
+  start_time_before = "2024-03-31T23:59:59Z"
+}
+
+output "prod_requests" {
+  value = [for request in data.awsteam_requests.prod.requests : "${request.requester} (${request.role}, ${request.duration}h): ${request.justification}"]
+}
+
+// Requests waiting for approval
+data "awsteam_requests" "pending" {
+  status = "pending"
+}
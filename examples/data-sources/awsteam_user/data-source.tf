@@ -0,0 +1,24 @@
+data "awsteam_user" "example" {
+  user_name = "my-user@contoso.com"
+}
+
+// The user name and id always come from the same user
+resource "awsteam_eligibility_user" "example" {
+  user_name         = data.awsteam_user.example.user_name
+  user_id           = data.awsteam_user.example.id
+  approval_required = true
+  duration          = 5
+  accounts = [
+    {
+      account_id   = "123456789012"
+      account_name = "My-aws-account"
+    }
+  ]
+  ous = []
+  permissions = [
+    {
+      permission_arn  = "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3"
+      permission_name = "elevated-permission"
+    }
+  ]
+}
@@ -0,0 +1,11 @@
+data "awsteam_users" "all" {}
+
+// Only return users with user names in the contoso.com domain
+data "awsteam_users" "contoso" {
+  name_regex = "@contoso\\.com$"
+}
+
+// How to access a user id from the user name mapping
+output "user_id" {
+  value = data.awsteam_users.all.by_user_name["my-user@contoso.com"]
+}
@@ -2,6 +2,7 @@ package acctest
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -36,7 +37,7 @@ func RunSerialTests2Levels(t *testing.T, testCases map[string]map[string]func(t
 	}
 }
 
-func NewAWSTeamClient(ctx context.Context) *awsteam.Client {
+func NewAWSTeamClient(ctx context.Context) (*awsteam.Client, error) {
 	clientId := os.Getenv(envvar.AWSTEAMClientId)
 	clientSecret := os.Getenv(envvar.AWSTEAMClientSecret)
 	graphEndpoint := os.Getenv(envvar.AWSTEAMGraphEndpoint)
@@ -49,7 +50,9 @@ func NewAWSTeamClient(ctx context.Context) *awsteam.Client {
 		TokenEndpoint: TokenEndpoint,
 	}
 
-	config.Build(ctx)
+	if err := config.Build(ctx); err != nil {
+		return nil, fmt.Errorf("building AWS TEAM client config: %w", err)
+	}
 
 	return config.NewClient(ctx)
 }
@@ -1,6 +1,18 @@
 package envvar
 
 const (
+	// Stores the AppSync API key sent instead of an oauth2 token.
+	AWSTEAMAPIKey = "AWSTEAM_API_KEY"
+
+	// Stores a static bearer token used instead of the oauth2 client credentials.
+	AWSTEAMAccessToken = "AWSTEAM_ACCESS_TOKEN"
+
+	// Stores the path to the aws-exports.js or amplify_outputs.json file of the AWS TEAM deployment.
+	AWSTEAMAmplifyConfigFile = "AWSTEAM_AMPLIFY_CONFIG_FILE"
+
+	// Stores how the provider authenticates to the graph endpoint, such as "client_credentials" or "iam".
+	AWSTEAMAuthMode = "AWSTEAM_AUTH_MODE"
+
 	// Stores the client id for authenticating to the oauth2 token endpoint.
 	AWSTEAMClientId = "AWSTEAM_CLIENT_ID"
 
@@ -12,4 +24,13 @@ const (
 
 	// Stores the token endpoint for the oath2 authenticator for AWS TEAMS.
 	AWSTEAMTokenEndpoint = "AWSTEAM_TOKEN_ENDPOINT"
+
+	// Stores the scopes requested with the oauth2 token, separated by spaces or commas.
+	AWSTEAMScopes = "AWSTEAM_SCOPES"
+
+	// Stores how the client credentials are sent to the oauth2 token endpoint, either "post" or "basic".
+	AWSTEAMTokenAuthMethod = "AWSTEAM_TOKEN_AUTH_METHOD"
+
+	// Stores a space separated command that prints a JSON token to stdout, used instead of the oauth2 client credentials.
+	AWSTEAMTokenCommand = "AWSTEAM_TOKEN_COMMAND"
 )
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &AccountDataSource{}
+
+func NewAccountDataSource() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+type AccountDataSource struct {
+	client *awsteam.Client
+}
+
+type AccountModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for a single AWS TEAM Account, looked up by id or name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The AWS account id. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^\d{12}$`),
+						"value must be a valid aws account id.",
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the AWS account. Exactly one of `id` or `name` must be set. The lookup fails unless exactly one account has this name.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetAccountsInput{}
+
+	out, err := d.client.GetAccounts(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read accounts, got error: %s", err))
+		return
+	}
+
+	var matches []*awsteam.Account
+	var lookup string
+
+	for _, account := range out.Accounts {
+		if !data.Id.IsNull() && ptr.ToString(account.Id) == data.Id.ValueString() {
+			matches = append(matches, account)
+		}
+
+		if !data.Name.IsNull() && ptr.ToString(account.Name) == data.Name.ValueString() {
+			matches = append(matches, account)
+		}
+	}
+
+	if !data.Id.IsNull() {
+		lookup = fmt.Sprintf("id %q", data.Id.ValueString())
+	} else {
+		lookup = fmt.Sprintf("name %q", data.Name.ValueString())
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Account Not Found", fmt.Sprintf("No account with %s was found.", lookup))
+		return
+	}
+
+	if len(matches) > 1 {
+		var ids []string
+
+		for _, account := range matches {
+			ids = append(ids, ptr.ToString(account.Id))
+		}
+
+		resp.Diagnostics.AddError("Multiple Accounts Found", fmt.Sprintf("%d accounts with %s were found: %v. Look the account up by id instead.", len(matches), lookup, ids))
+		return
+	}
+
+	data.flatten(matches[0])
+	tflog.Trace(ctx, "read account data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *AccountModel) flatten(out *awsteam.Account) {
+	d.Id = types.StringPointerValue(out.Id)
+	d.Name = types.StringPointerValue(out.Name)
+}
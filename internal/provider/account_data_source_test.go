@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_account.test"
+
+	// This environment variable should be set to a name of one of the accounts expected to be returned.
+	expectedAccountNameVar := "AWSTEAM_TESTS_EXPECTED_ACCOUNT_NAME"
+	expectedAccountName := os.Getenv(expectedAccountNameVar)
+	if expectedAccountName == "" {
+		t.Skipf("Skipping Account Tests, Environment variable %s is not set.", expectedAccountNameVar)
+	}
+
+	// This environment variable should be set to the account ID of the account name provided expected to be returned.
+	expectedAccountIdVar := "AWSTEAM_TESTS_EXPECTED_ACCOUNT_ID"
+	expectedAccountId := os.Getenv(expectedAccountIdVar)
+	if expectedAccountId == "" {
+		t.Skipf("Skipping Account Tests, Environment variable %s is not set.", expectedAccountIdVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountDataSourceConfig_name(expectedAccountName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", expectedAccountId),
+					resource.TestCheckResourceAttr(dataSourceName, "name", expectedAccountName),
+				),
+			},
+			{
+				Config: testAccAccountDataSourceConfig_id(expectedAccountId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", expectedAccountId),
+					resource.TestCheckResourceAttr(dataSourceName, "name", expectedAccountName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccountDataSourceConfig_name("tf-acc-test-account-does-not-exist"),
+				ExpectError: regexp.MustCompile(`No account with name "tf-acc-test-account-does-not-exist" was found`),
+			},
+		},
+	})
+}
+
+func testAccAccountDataSourceConfig_id(id string) string {
+	return fmt.Sprintf(`
+data "awsteam_account" "test" {
+  id = %q
+}
+`, id)
+}
+
+func testAccAccountDataSourceConfig_name(name string) string {
+	return fmt.Sprintf(`
+data "awsteam_account" "test" {
+  name = %q
+}
+`, name)
+}
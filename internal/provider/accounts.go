@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+)
+
+type accountsFilter struct {
+	NameRegex *regexp.Regexp
+	Ids       map[string]bool
+	OUIds     map[string]bool // Ids of the OUs that accounts must be in, including nested OUs
+}
+
+func filterAccounts(accounts []*awsteam.Account, filter accountsFilter) []*awsteam.Account {
+	var filtered []*awsteam.Account
+
+	for _, account := range accounts {
+		if filter.NameRegex != nil && !filter.NameRegex.MatchString(ptr.ToString(account.Name)) {
+			continue
+		}
+
+		if filter.Ids != nil && !filter.Ids[ptr.ToString(account.Id)] {
+			continue
+		}
+
+		if filter.OUIds != nil && !filter.OUIds[ptr.ToString(account.Id)] {
+			continue
+		}
+
+		filtered = append(filtered, account)
+	}
+
+	return filtered
+}
+
+// ouAccountIds returns the ids of the accounts in the OU and all of the OUs nested below it.
+func ouAccountIds(ctx context.Context, client *awsteam.Client, ouId string) (map[string]bool, error) {
+	ous, err := client.GetOUs(ctx, &awsteam.GetOUsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	ou := awsteam.FindOUById(ous.OUs, ouId)
+
+	if ou == nil {
+		return nil, fmt.Errorf("OU %s not found in the organization", ouId)
+	}
+
+	accounts, err := ouTreeAccounts(ctx, client, ou, map[string][]*awsteam.Account{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	accountIds := map[string]bool{}
+
+	for _, account := range accounts {
+		accountIds[ptr.ToString(account.Id)] = true
+	}
+
+	return accountIds, nil
+}
+
+// ouTreeAccounts returns the accounts in the OU and all of the OUs nested below it. The accounts
+// of each OU are stored in cache so OUs shared by several lookups are only read once.
+func ouTreeAccounts(ctx context.Context, client *awsteam.Client, ou *awsteam.OU, cache map[string][]*awsteam.Account) ([]*awsteam.Account, error) {
+	var accounts []*awsteam.Account
+	var walkErr error
+
+	awsteam.WalkOUs([]awsteam.OU{*ou}, func(ou *awsteam.OU, _ []*awsteam.OU) bool {
+		id := ptr.ToString(ou.Id)
+
+		if _, ok := cache[id]; !ok {
+			out, err := client.GetOU(ctx, &awsteam.GetOUInput{Id: ou.Id})
+
+			if err != nil {
+				walkErr = err
+				return false
+			}
+
+			cache[id] = out.Accounts
+		}
+
+		accounts = append(accounts, cache[id]...)
+
+		return true
+	})
+
+	if walkErr != nil {
+		return nil, walkErr
+	}
+
+	return accounts, nil
+}
+
+// accountOUPath returns the OUs containing the account, ordered from the organization root down to
+// the OU the account is directly in. It returns a NotFoundError when the account is not in any OU
+// visible to AWS TEAM.
+func accountOUPath(ctx context.Context, client *awsteam.Client, accountId string) ([]*awsteam.OU, error) {
+	ous, err := client.GetOUs(ctx, &awsteam.GetOUsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var path []*awsteam.OU
+	var walkErr error
+
+	awsteam.WalkOUs(ous.OUs, func(ou *awsteam.OU, ancestors []*awsteam.OU) bool {
+		out, err := client.GetOU(ctx, &awsteam.GetOUInput{Id: ou.Id})
+
+		if err != nil {
+			walkErr = err
+			return false
+		}
+
+		for _, account := range out.Accounts {
+			if ptr.ToString(account.Id) == accountId {
+				path = append(append([]*awsteam.OU{}, ancestors...), ou)
+				return false
+			}
+		}
+
+		return true
+	})
+
+	if walkErr != nil {
+		return nil, walkErr
+	}
+
+	if path == nil {
+		return nil, &awsteam.NotFoundError{GraphQLError: awsteam.GraphQLError{
+			ErrorType: "NotFound",
+			Message:   fmt.Sprintf("account %q is not in any OU visible to AWS TEAM", accountId),
+		}}
+	}
+
+	return path, nil
+}
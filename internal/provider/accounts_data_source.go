@@ -3,12 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/ptr"
 	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -30,8 +38,12 @@ type AccountsDataSource struct {
 }
 
 type AccountsModel struct {
-	Id       types.String `tfsdk:"id"`
-	Accounts types.Set    `tfsdk:"accounts"`
+	Id        types.String `tfsdk:"id"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Ids       types.Set    `tfsdk:"ids"`
+	OUId      types.String `tfsdk:"ou_id"`
+	Accounts  types.Set    `tfsdk:"accounts"`
+	ByName    types.Map    `tfsdk:"by_name"`
 }
 
 func (d *AccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,6 +59,28 @@ func (d *AccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "Accounts Identifier. This is a static value of `accounts` as it contains all accounts.",
 				Computed:            true,
 			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression used to filter accounts by name.",
+				Optional:            true,
+				Validators: []validator.String{
+					ValidRegex(),
+				},
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "A set of AWS account ids used to filter accounts.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"ou_id": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts in this OU or in any of the OUs nested below it.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$`),
+						"value must be a valid ou id.",
+					),
+				},
+			},
 			"accounts": schema.SetNestedAttribute{
 				MarkdownDescription: "A set of AWS accounts.",
 				Computed:            true,
@@ -63,6 +97,11 @@ func (d *AccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 					},
 				},
 			},
+			"by_name": schema.MapAttribute{
+				MarkdownDescription: "A map of account name to AWS account id for the accounts returned. Names shared by more than one account are left out of the map with a warning, so use `ids` or the `awsteam_account` data source when account names may not be unique.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -100,7 +139,7 @@ func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	out, err := d.client.GetAccounts(ctx, in)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read accounts, got error: %s", err))
 		return
 	}
 
@@ -109,8 +148,56 @@ func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	data.flatten(out)
-	tflog.Trace(ctx, "read settings resource")
+	filter := accountsFilter{}
+
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Unable to compile %q, got error: %s", data.NameRegex.ValueString(), err),
+			)
+			return
+		}
+
+		filter.NameRegex = nameRegex
+	}
+
+	if !data.Ids.IsNull() {
+		var ids []string
+		resp.Diagnostics.Append(data.Ids.ElementsAs(ctx, &ids, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		filter.Ids = map[string]bool{}
+
+		for _, id := range ids {
+			filter.Ids[id] = true
+		}
+	}
+
+	if !data.OUId.IsNull() {
+		filter.OUIds, err = ouAccountIds(ctx, d.client, data.OUId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read accounts in OU %s, got error: %s", data.OUId.ValueString(), err))
+			return
+		}
+	}
+
+	out.Accounts = filterAccounts(out.Accounts, filter)
+
+	resp.Diagnostics.Append(data.flatten(out)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read accounts data source")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -118,18 +205,71 @@ func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 func (d *AccountsModel) flatten(out *awsteam.GetAccountsOutput) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	accountsSet, diags := flattenAccounts(out.Accounts)
-	diags.Append(diags...)
+	accountsSet, diag := flattenAccounts(out.Accounts)
+	diags.Append(diag...)
+
+	names := make([]*string, 0, len(out.Accounts))
+	ids := make([]*string, 0, len(out.Accounts))
+
+	for _, account := range out.Accounts {
+		names = append(names, account.Name)
+		ids = append(ids, account.Id)
+	}
+
+	byNameMap, diag := flattenNameMap("by_name", names, ids)
+	diags.Append(diag...)
+
 	if diags.HasError() {
 		return diags
 	}
 
 	d.Id = types.StringValue("accounts")
 	d.Accounts = accountsSet
+	d.ByName = byNameMap
 
 	return diags
 }
 
+// flattenNameMap returns a map of each name to the id at the same index. Names shared by more than
+// one id are left out, as either id could be meant, and are listed in a warning on the attribute.
+func flattenNameMap(attribute string, names []*string, ids []*string) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	counts := map[string]int{}
+
+	for _, name := range names {
+		counts[ptr.ToString(name)]++
+	}
+
+	elems := map[string]attr.Value{}
+	var duplicates []string
+
+	for i, name := range names {
+		if counts[ptr.ToString(name)] == 1 {
+			elems[ptr.ToString(name)] = types.StringPointerValue(ids[i])
+		}
+	}
+
+	for name, count := range counts {
+		if count > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("%q", name))
+		}
+	}
+
+	if len(duplicates) > 0 {
+		sort.Strings(duplicates)
+		diags.AddAttributeWarning(
+			path.Root(attribute),
+			"Duplicate Names",
+			fmt.Sprintf("The names %s are shared by more than one item, so they are left out of %s. Filter by id to select one of them.", strings.Join(duplicates, ", "), attribute),
+		)
+	}
+
+	mapVal, d := types.MapValue(types.StringType, elems)
+	diags.Append(d...)
+
+	return mapVal, diags
+}
+
 func flattenAccounts(apiObject []*awsteam.Account) (types.Set, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	elemType := types.ObjectType{AttrTypes: accountsAttrTypes}
@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
+	"github.com/aws/smithy-go/ptr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -45,6 +49,59 @@ func TestAccAccountsDataSource_basic(t *testing.T) {
 							"id":   expectedAccountsId,
 							"name": expectedAccountsName,
 						}),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("by_name.%s", expectedAccountsName), expectedAccountsId),
+				),
+			},
+			{
+				Config: testAccAccountsDataSourceConfig_ids(expectedAccountsId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "accounts.*",
+						map[string]string{
+							"id":   expectedAccountsId,
+							"name": expectedAccountsName,
+						}),
+				),
+			},
+			{
+				Config: testAccAccountsDataSourceConfig_nameRegex("^" + regexp.QuoteMeta(expectedAccountsName) + "$"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "by_name.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountsDataSource_ouId(t *testing.T) {
+	dataSourceName := "data.awsteam_accounts.test"
+
+	// This environment variable should be set to the id of an OU expected to be returned.
+	expectedOUIdVar := "AWSTEAM_TESTS_EXPECTED_OU_ID"
+	expectedOUId := os.Getenv(expectedOUIdVar)
+	if expectedOUId == "" {
+		t.Skipf("Skipping Accounts Tests, Environment variable %s is not set.", expectedOUIdVar)
+	}
+
+	// This environment variable should be set to the id of an account in the OU provided, directly or in a nested OU.
+	expectedOUAccountIdVar := "AWSTEAM_TESTS_EXPECTED_OU_ACCOUNT_ID"
+	expectedOUAccountId := os.Getenv(expectedOUAccountIdVar)
+	if expectedOUAccountId == "" {
+		t.Skipf("Skipping Accounts Tests, Environment variable %s is not set.", expectedOUAccountIdVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountsDataSourceConfig_ouId(expectedOUId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "accounts.*",
+						map[string]string{
+							"id": expectedOUAccountId,
+						}),
 				),
 			},
 		},
@@ -54,3 +111,48 @@ func TestAccAccountsDataSource_basic(t *testing.T) {
 func testAccAccountsDataSourceConfig() string {
 	return `data "awsteam_accounts" "test" {}`
 }
+
+func testAccAccountsDataSourceConfig_ids(id string) string {
+	return fmt.Sprintf(`
+data "awsteam_accounts" "test" {
+  ids = [%q]
+}
+`, id)
+}
+
+func testAccAccountsDataSourceConfig_nameRegex(nameRegex string) string {
+	return fmt.Sprintf(`
+data "awsteam_accounts" "test" {
+  name_regex = %q
+}
+`, nameRegex)
+}
+
+func testAccAccountsDataSourceConfig_ouId(ouId string) string {
+	return fmt.Sprintf(`
+data "awsteam_accounts" "test" {
+  ou_id = %q
+}
+`, ouId)
+}
+
+func TestFlattenNameMap_duplicates(t *testing.T) {
+	names := []*string{ptr.String("sandbox"), ptr.String("production"), ptr.String("sandbox")}
+	ids := []*string{ptr.String("111111111111"), ptr.String("222222222222"), ptr.String("333333333333")}
+
+	got, diags := flattenNameMap("by_name", names, ids)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected a warning for the duplicate name, got %v", diags)
+	}
+
+	elems := got.Elements()
+
+	if len(elems) != 1 || !elems["production"].Equal(types.StringValue("222222222222")) {
+		t.Errorf("expected only the unique name to be mapped, got %v", elems)
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+)
+
+// newOUTreeClient returns a client for a graph endpoint serving an organization with a Workloads OU
+// containing a Prod OU, each with one account.
+func newOUTreeClient(t *testing.T) *awsteam.Client {
+	t.Helper()
+
+	ous := `[{"Id": "ou-a1b2-11111111", "Name": "Workloads", "Children": [{"Id": "ou-a1b2-22222222", "Name": "Prod", "Children": []}]}]`
+	accounts := map[string]string{
+		"ou-a1b2-11111111": `[{"Id": "111111111111", "Name": "shared"}]`,
+		"ou-a1b2-22222222": `[{"Id": "222222222222", "Name": "prod-app"}]`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := struct {
+			Query     string            `json:"query"`
+			Variables map[string]string `json:"variables"`
+		}{}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var data map[string]interface{}
+
+		if strings.Contains(req.Query, "getOUs") {
+			data = map[string]interface{}{"getOUs": map[string]string{"ous": ous}}
+		} else {
+			data = map[string]interface{}{"getOU": map[string]string{"accounts": accounts[req.Variables["id"]]}}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+
+	t.Cleanup(server.Close)
+
+	return &awsteam.Client{
+		GraphEndpoint: server.URL,
+		HTTPClient:    server.Client(),
+		Retryer:       &awsteam.Retryer{MaxAttempts: 1},
+	}
+}
+
+func TestAccountOUPath(t *testing.T) {
+	client := newOUTreeClient(t)
+
+	path, err := accountOUPath(context.Background(), client, "222222222222")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var ids []string
+
+	for _, ou := range path {
+		ids = append(ids, ptr.ToString(ou.Id))
+	}
+
+	if strings.Join(ids, ",") != "ou-a1b2-11111111,ou-a1b2-22222222" {
+		t.Errorf("expected the path from the root OU down to Prod, got %v", ids)
+	}
+}
+
+func TestAccountOUPath_notFound(t *testing.T) {
+	client := newOUTreeClient(t)
+
+	_, err := accountOUPath(context.Background(), client, "999999999999")
+
+	var notFound *awsteam.NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected NotFoundError, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/names"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ApproversDataSource{}
+
+func NewApproversDataSource() datasource.DataSource {
+	return &ApproversDataSource{}
+}
+
+type ApproversDataSource struct {
+	client *awsteam.Client
+}
+
+type ApproversModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Approvers  types.Set    `tfsdk:"approvers"`
+	GroupIds   types.Set    `tfsdk:"group_ids"`
+	TicketNo   types.String `tfsdk:"ticket_no"`
+	ModifiedBy types.String `tfsdk:"modified_by"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+func (d *ApproversDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_approvers"
+}
+
+func (d *ApproversDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for an existing AWS TEAM approvers policy of an aws account or OU",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The AWS account id or OU id of the approvers policy.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the AWS account or OU the approvers policy applies to.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the approvers policy, either `Account` or `OU`.",
+				Computed:            true,
+			},
+			"approvers": schema.SetAttribute{
+				MarkdownDescription: "The list of group names that are approvers for the account or OU.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"group_ids": schema.SetAttribute{
+				MarkdownDescription: "The list of group ids that are approvers for the account or OU.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"ticket_no": schema.StringAttribute{
+				MarkdownDescription: "The Change Management system ticket system number.",
+				Computed:            true,
+			},
+			names.AttrModifiedBy: schema.StringAttribute{
+				MarkdownDescription: "The user to last modify the item",
+				Computed:            true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				MarkdownDescription: "The date and time that the item was created",
+				Computed:            true,
+			},
+			names.AttrUpdatedAt: schema.StringAttribute{
+				MarkdownDescription: "The date and time of the last time the item was updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ApproversDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApproversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApproversModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetApproversInput{
+		Id: data.Id.ValueStringPointer(),
+	}
+
+	out, err := d.client.GetApprovers(ctx, in)
+
+	var notFound *awsteam.NotFoundError
+	if errors.As(err, &notFound) {
+		resp.Diagnostics.AddError("Approvers Not Found", fmt.Sprintf("No approvers policy with id %q was found.", data.Id.ValueString()))
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read approvers, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(ctx, out.Approvers)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read approvers data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ApproversModel) flatten(ctx context.Context, out *awsteam.Approvers) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	approversSet, diag := types.SetValueFrom(ctx, types.StringType, out.Approvers)
+	diags.Append(diag...)
+
+	groupIdsSet, diag := types.SetValueFrom(ctx, types.StringType, out.GroupIds)
+	diags.Append(diag...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringPointerValue(out.Id)
+	d.Name = types.StringPointerValue(out.Name)
+	d.Type = types.StringPointerValue(out.Type)
+	d.Approvers = approversSet
+	d.GroupIds = groupIdsSet
+	d.TicketNo = types.StringPointerValue(out.TicketNo)
+	d.ModifiedBy = types.StringPointerValue(out.ModifiedBy)
+	d.CreatedAt = types.StringPointerValue(out.CreatedAt)
+	d.UpdatedAt = types.StringPointerValue(out.UpdatedAt)
+
+	return diags
+}
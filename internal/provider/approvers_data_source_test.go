@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApproversDataSource_basic(t *testing.T) {
+	resourceName := "awsteam_approvers_account.test"
+	dataSourceName := "data.awsteam_approvers.test"
+	accountId := gofakeit.DigitN(12)
+	accountName := gofakeit.BS()
+	approver := gofakeit.Email()
+	groupId := gofakeit.UUID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApproversDataSourceConfig(accountId, accountName, approver, groupId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", accountName),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "Account"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "approvers.*", approver),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "group_ids.*", groupId),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_at", resourceName, "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApproversDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApproversDataSourceConfig_id(gofakeit.DigitN(12)),
+				ExpectError: regexp.MustCompile(`Approvers Not Found`),
+			},
+		},
+	})
+}
+
+func testAccApproversDataSourceConfig(accountId, accountName, approver, groupId string) string {
+	return testAccApproversAccountResourceConfig(accountId, accountName, approver, groupId) + `
+
+data "awsteam_approvers" "test" {
+  id = awsteam_approvers_account.test.id
+}
+`
+}
+
+func testAccApproversDataSourceConfig_id(id string) string {
+	return fmt.Sprintf(`
+data "awsteam_approvers" "test" {
+  id = %q
+}
+`, id)
+}
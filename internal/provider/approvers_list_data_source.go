@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/names"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	approversListAttrTypes = map[string]attr.Type{
+		"id":                 types.StringType,
+		"name":               types.StringType,
+		"type":               types.StringType,
+		"approvers":          types.SetType{ElemType: types.StringType},
+		"group_ids":          types.SetType{ElemType: types.StringType},
+		"ticket_no":          types.StringType,
+		names.AttrModifiedBy: types.StringType,
+		names.AttrCreatedAt:  types.StringType,
+		names.AttrUpdatedAt:  types.StringType,
+	}
+)
+var _ datasource.DataSource = &ApproversListDataSource{}
+
+func NewApproversListDataSource() datasource.DataSource {
+	return &ApproversListDataSource{}
+}
+
+type ApproversListDataSource struct {
+	client *awsteam.Client
+}
+
+type ApproversListModel struct {
+	Id            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	ApproversList types.List   `tfsdk:"approvers_list"`
+}
+
+func (d *ApproversListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_approvers_list"
+}
+
+func (d *ApproversListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the approvers policies of an AWS TEAM deployment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Approvers List Identifier. This is a static value of `approvers_list`.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return approvers policies of this type, either `Account` or `OU`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ApproversAccountType, ApproversOUType),
+				},
+			},
+			"approvers_list": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of approvers policies, sorted by type and name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The AWS account id or OU id of the approvers policy.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the AWS account or OU the approvers policy applies to.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the approvers policy, either `Account` or `OU`.",
+							Computed:            true,
+						},
+						"approvers": schema.SetAttribute{
+							MarkdownDescription: "The list of group names that are approvers for the account or OU.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"group_ids": schema.SetAttribute{
+							MarkdownDescription: "The list of group ids that are approvers for the account or OU.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"ticket_no": schema.StringAttribute{
+							MarkdownDescription: "The Change Management system ticket system number.",
+							Computed:            true,
+						},
+						names.AttrModifiedBy: schema.StringAttribute{
+							MarkdownDescription: "The user to last modify the item",
+							Computed:            true,
+						},
+						names.AttrCreatedAt: schema.StringAttribute{
+							MarkdownDescription: "The date and time that the item was created",
+							Computed:            true,
+						},
+						names.AttrUpdatedAt: schema.StringAttribute{
+							MarkdownDescription: "The date and time of the last time the item was updated",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApproversListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApproversListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApproversListModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.ListApproversInput{
+		Type: data.Type.ValueStringPointer(),
+	}
+
+	approvers, err := listAllApprovers(ctx, d.client, in)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list approvers, got error: %s", err))
+		return
+	}
+
+	sort.SliceStable(approvers, func(i, j int) bool {
+		if ptr.ToString(approvers[i].Type) != ptr.ToString(approvers[j].Type) {
+			return ptr.ToString(approvers[i].Type) < ptr.ToString(approvers[j].Type)
+		}
+
+		return ptr.ToString(approvers[i].Name) < ptr.ToString(approvers[j].Name)
+	})
+
+	resp.Diagnostics.Append(data.flatten(ctx, approvers)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read approvers list data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ApproversListModel) flatten(ctx context.Context, approvers []*awsteam.Approvers) diag.Diagnostics {
+	approversList, diags := flattenApproversList(ctx, approvers)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("approvers_list")
+	d.ApproversList = approversList
+
+	return diags
+}
+
+// listAllApprovers pages through ListApprovers and returns every approvers policy.
+func listAllApprovers(ctx context.Context, client *awsteam.Client, in *awsteam.ListApproversInput) ([]*awsteam.Approvers, error) {
+	var approvers []*awsteam.Approvers
+	paginator := awsteam.NewListApproversPaginator(client, in)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		approvers = append(approvers, page.Approvers...)
+	}
+
+	return approvers, nil
+}
+
+func flattenApproversList(ctx context.Context, apiObject []*awsteam.Approvers) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: approversListAttrTypes}
+	elems := []attr.Value{}
+
+	for _, approvers := range apiObject {
+		approversSet, d := types.SetValueFrom(ctx, types.StringType, approvers.Approvers)
+		diags.Append(d...)
+
+		groupIdsSet, d := types.SetValueFrom(ctx, types.StringType, approvers.GroupIds)
+		diags.Append(d...)
+
+		obj := map[string]attr.Value{
+			"id":                 types.StringPointerValue(approvers.Id),
+			"name":               types.StringPointerValue(approvers.Name),
+			"type":               types.StringPointerValue(approvers.Type),
+			"approvers":          approversSet,
+			"group_ids":          groupIdsSet,
+			"ticket_no":          types.StringPointerValue(approvers.TicketNo),
+			names.AttrModifiedBy: types.StringPointerValue(approvers.ModifiedBy),
+			names.AttrCreatedAt:  types.StringPointerValue(approvers.CreatedAt),
+			names.AttrUpdatedAt:  types.StringPointerValue(approvers.UpdatedAt),
+		}
+		objVal, d := types.ObjectValue(approversListAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(elemType), diags
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApproversListDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_approvers_list.test"
+	accountId := gofakeit.DigitN(12)
+	accountName := gofakeit.BS()
+	approver := gofakeit.Email()
+	groupId := gofakeit.UUID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApproversListDataSourceConfig(accountId, accountName, approver, groupId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "approvers_list"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "approvers_list.*",
+						map[string]string{
+							"id":          accountId,
+							"name":        accountName,
+							"type":        "Account",
+							"approvers.0": approver,
+							"group_ids.0": groupId,
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccApproversListDataSourceConfig(accountId, accountName, approver, groupId string) string {
+	return testAccApproversAccountResourceConfig(accountId, accountName, approver, groupId) + `
+
+data "awsteam_approvers_list" "test" {
+  type = "Account"
+
+  depends_on = [awsteam_approvers_account.test]
+}
+`
+}
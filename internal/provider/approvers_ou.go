@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/YakDriver/regexache"
@@ -209,6 +210,13 @@ func (r *ApproversOUResource) Read(ctx context.Context, req resource.ReadRequest
 
 	out, err := r.client.GetApprovers(ctx, in)
 
+	var notFound *awsteam.NotFoundError
+	if errors.As(err, &notFound) {
+		tflog.Warn(ctx, "Approvers not found, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read approvers ou policy, got error: %s", err))
 		return
@@ -344,6 +352,11 @@ func (r *ApproversOUResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	_, err := r.client.DeleteApprovers(ctx, in)
 
+	var notFound *awsteam.NotFoundError
+	if errors.As(err, &notFound) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete approvers ou, got error: %s", err))
 		return
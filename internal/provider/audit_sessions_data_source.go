@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	auditSessionsAttrTypes = map[string]attr.Type{
+		"request_id":       types.StringType,
+		"username":         types.StringType,
+		"account_id":       types.StringType,
+		"role":             types.StringType,
+		"start_time":       types.StringType,
+		"end_time":         types.StringType,
+		"duration_minutes": types.Int64Type,
+		"revoked":          types.BoolType,
+		"revoker":          types.StringType,
+		"revoker_id":       types.StringType,
+		"revoke_comment":   types.StringType,
+	}
+)
+var _ datasource.DataSource = &AuditSessionsDataSource{}
+
+func NewAuditSessionsDataSource() datasource.DataSource {
+	return &AuditSessionsDataSource{}
+}
+
+type AuditSessionsDataSource struct {
+	client *awsteam.Client
+}
+
+type AuditSessionsModel struct {
+	Id        types.String `tfsdk:"id"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+	Sessions  types.List   `tfsdk:"sessions"`
+}
+
+func (d *AuditSessionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_sessions"
+}
+
+func (d *AuditSessionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the completed elevated access sessions of an AWS TEAM deployment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Audit Sessions Identifier. This is a static value of `audit_sessions`.",
+				Computed:            true,
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Only return sessions starting at or after this RFC 3339 timestamp.",
+				Optional:            true,
+				Validators: []validator.String{
+					ValidRFC3339(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "Only return sessions ending at or before this RFC 3339 timestamp.",
+				Optional:            true,
+				Validators: []validator.String{
+					ValidRFC3339(),
+				},
+			},
+			"sessions": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of completed sessions, sorted by start time. Sessions that have not ended yet are not included.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"request_id": schema.StringAttribute{
+							MarkdownDescription: "The id of the request the session was started for.",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "User name of the user that assumed the role.",
+							Computed:            true,
+						},
+						"account_id": schema.StringAttribute{
+							MarkdownDescription: "The AWS account id the role was assumed in.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Name of the assumed permission set.",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "The date and time the session started.",
+							Computed:            true,
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "The date and time the session ended.",
+							Computed:            true,
+						},
+						"duration_minutes": schema.Int64Attribute{
+							MarkdownDescription: "The length of the session in whole minutes.",
+							Computed:            true,
+						},
+						"revoked": schema.BoolAttribute{
+							MarkdownDescription: "Whether elevated access was revoked before the session was due to end.",
+							Computed:            true,
+						},
+						"revoker": schema.StringAttribute{
+							MarkdownDescription: "Email address of the user that revoked elevated access.",
+							Computed:            true,
+						},
+						"revoker_id": schema.StringAttribute{
+							MarkdownDescription: "The id of the user that revoked elevated access.",
+							Computed:            true,
+						},
+						"revoke_comment": schema.StringAttribute{
+							MarkdownDescription: "The comment given when elevated access was revoked.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditSessionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuditSessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditSessionsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startTime, diags := requestTime(path.Root("start_time"), data.StartTime)
+	resp.Diagnostics.Append(diags...)
+
+	endTime, diags := requestTime(path.Root("end_time"), data.EndTime)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.ListSessionsInput{
+		StartTimeAfter: startTime,
+		EndTimeBefore:  endTime,
+	}
+
+	var sessions []*awsteam.Session
+	paginator := awsteam.NewListSessionsPaginator(d.client, in)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list sessions, got error: %s", err))
+			return
+		}
+
+		sessions = append(sessions, page.Sessions...)
+	}
+
+	sessions = completedSessions(sessions, time.Now())
+
+	// Sessions share their id and start time with the request they were started for, which holds the
+	// revoke details, so only revoked requests starting within the same window are looked up.
+	revoked := map[string]*awsteam.Request{}
+
+	if len(sessions) > 0 {
+		requestsPaginator := awsteam.NewListRequestsPaginator(d.client, &awsteam.ListRequestsInput{
+			Status:          ptr.String("revoked"),
+			StartTimeAfter:  startTime,
+			StartTimeBefore: endTime,
+		})
+
+		for requestsPaginator.HasMorePages() {
+			page, err := requestsPaginator.NextPage(ctx)
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list revoked requests, got error: %s", err))
+				return
+			}
+
+			for _, request := range page.Requests {
+				revoked[ptr.ToString(request.Id)] = request
+			}
+		}
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		if ptr.ToString(sessions[i].StartTime) != ptr.ToString(sessions[j].StartTime) {
+			return ptr.ToString(sessions[i].StartTime) < ptr.ToString(sessions[j].StartTime)
+		}
+
+		return ptr.ToString(sessions[i].Id) < ptr.ToString(sessions[j].Id)
+	})
+
+	resp.Diagnostics.Append(data.flatten(sessions, revoked)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read audit sessions data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *AuditSessionsModel) flatten(sessions []*awsteam.Session, revoked map[string]*awsteam.Request) diag.Diagnostics {
+	sessionsList, diags := flattenAuditSessions(sessions, revoked)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("audit_sessions")
+	d.Sessions = sessionsList
+
+	return diags
+}
+
+// completedSessions returns the sessions that ended before now.
+func completedSessions(sessions []*awsteam.Session, now time.Time) []*awsteam.Session {
+	var completed []*awsteam.Session
+
+	for _, session := range sessions {
+		endTime, err := time.Parse(time.RFC3339, ptr.ToString(session.EndTime))
+
+		if err != nil || endTime.After(now) {
+			continue
+		}
+
+		completed = append(completed, session)
+	}
+
+	return completed
+}
+
+// sessionDuration returns the length of the session in whole minutes, or null when either time
+// cannot be parsed.
+func sessionDuration(session *awsteam.Session) types.Int64 {
+	startTime, err := time.Parse(time.RFC3339, ptr.ToString(session.StartTime))
+
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	endTime, err := time.Parse(time.RFC3339, ptr.ToString(session.EndTime))
+
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(endTime.Sub(startTime) / time.Minute))
+}
+
+func flattenAuditSessions(apiObject []*awsteam.Session, revoked map[string]*awsteam.Request) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: auditSessionsAttrTypes}
+	elems := []attr.Value{}
+
+	for _, session := range apiObject {
+		request, isRevoked := revoked[ptr.ToString(session.Id)]
+
+		if !isRevoked {
+			request = &awsteam.Request{}
+		}
+
+		obj := map[string]attr.Value{
+			"request_id":       types.StringPointerValue(session.Id),
+			"username":         types.StringPointerValue(session.Username),
+			"account_id":       types.StringPointerValue(session.AccountId),
+			"role":             types.StringPointerValue(session.Role),
+			"start_time":       types.StringPointerValue(session.StartTime),
+			"end_time":         types.StringPointerValue(session.EndTime),
+			"duration_minutes": sessionDuration(session),
+			"revoked":          types.BoolValue(isRevoked),
+			"revoker":          types.StringPointerValue(request.Revoker),
+			"revoker_id":       types.StringPointerValue(request.RevokerId),
+			"revoke_comment":   types.StringPointerValue(request.RevokeComment),
+		}
+		objVal, d := types.ObjectValue(auditSessionsAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(elemType), diags
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
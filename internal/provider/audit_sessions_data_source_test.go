@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuditSessionsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_audit_sessions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// TEAM was not released yet, so there can be no sessions in this window.
+				Config: testAccAuditSessionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "audit_sessions"),
+					resource.TestCheckResourceAttr(dataSourceName, "sessions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuditSessionsDataSourceConfig() string {
+	return `
+data "awsteam_audit_sessions" "test" {
+  start_time = "2000-01-01T00:00:00Z"
+  end_time   = "2000-12-31T23:59:59Z"
+}
+`
+}
+
+func TestFlattenAuditSessions_revoked(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	sessions := []*awsteam.Session{
+		{Id: ptr.String("ended"), Username: ptr.String("alice"), StartTime: ptr.String("2024-06-01T08:00:00Z"), EndTime: ptr.String("2024-06-01T09:00:00Z")},
+		{Id: ptr.String("revoked"), Username: ptr.String("bob"), StartTime: ptr.String("2024-06-01T10:00:00Z"), EndTime: ptr.String("2024-06-01T10:15:00Z")},
+		{Id: ptr.String("ongoing"), Username: ptr.String("carol"), StartTime: ptr.String("2024-06-01T11:00:00Z"), EndTime: ptr.String("2024-06-01T13:00:00Z")},
+	}
+	revoked := map[string]*awsteam.Request{
+		"revoked": {Id: ptr.String("revoked"), Status: ptr.String("revoked"), Revoker: ptr.String("admin@example.com"), RevokerId: ptr.String("admin"), RevokeComment: ptr.String("no longer needed")},
+	}
+
+	completed := completedSessions(sessions, now)
+
+	if len(completed) != 2 {
+		t.Fatalf("expected the ongoing session to be excluded, got %d sessions", len(completed))
+	}
+
+	list, diags := flattenAuditSessions(completed, revoked)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	testCases := []struct {
+		requestId       string
+		durationMinutes int64
+		revoked         bool
+		revoker         types.String
+		revokeComment   types.String
+	}{
+		{"ended", 60, false, types.StringNull(), types.StringNull()},
+		{"revoked", 15, true, types.StringValue("admin@example.com"), types.StringValue("no longer needed")},
+	}
+
+	for i, tc := range testCases {
+		attrs := list.Elements()[i].(types.Object).Attributes()
+
+		if got := attrs["request_id"]; !got.Equal(types.StringValue(tc.requestId)) {
+			t.Errorf("session %d: expected request_id %q, got %s", i, tc.requestId, got)
+		}
+
+		if got := attrs["duration_minutes"]; !got.Equal(types.Int64Value(tc.durationMinutes)) {
+			t.Errorf("session %d: expected duration_minutes %d, got %s", i, tc.durationMinutes, got)
+		}
+
+		if got := attrs["revoked"]; !got.Equal(types.BoolValue(tc.revoked)) {
+			t.Errorf("session %d: expected revoked %t, got %s", i, tc.revoked, got)
+		}
+
+		if got := attrs["revoker"]; !got.Equal(tc.revoker) {
+			t.Errorf("session %d: expected revoker %s, got %s", i, tc.revoker, got)
+		}
+
+		if got := attrs["revoke_comment"]; !got.Equal(tc.revokeComment) {
+			t.Errorf("session %d: expected revoke_comment %s, got %s", i, tc.revokeComment, got)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	effectiveApproversAttrTypes = map[string]attr.Type{
+		"group_id":    types.StringType,
+		"group_name":  types.StringType,
+		"source_id":   types.StringType,
+		"source_name": types.StringType,
+		"source_type": types.StringType,
+	}
+)
+var _ datasource.DataSource = &EffectiveApproversDataSource{}
+
+func NewEffectiveApproversDataSource() datasource.DataSource {
+	return &EffectiveApproversDataSource{}
+}
+
+type EffectiveApproversDataSource struct {
+	client *awsteam.Client
+}
+
+type EffectiveApproversModel struct {
+	Id        types.String `tfsdk:"id"`
+	AccountId types.String `tfsdk:"account_id"`
+	OUIds     types.List   `tfsdk:"ou_ids"`
+	Approvers types.List   `tfsdk:"approvers"`
+	GroupIds  types.Set    `tfsdk:"group_ids"`
+}
+
+func (d *EffectiveApproversDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_approvers"
+}
+
+func (d *EffectiveApproversDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source resolving the approvers of an aws account from the approvers policies of the account and each of the OUs above it",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The AWS account id.",
+				Computed:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The AWS account id to resolve the approvers of. It must be in an OU visible to AWS TEAM.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^\d{12}$`),
+						"value must be a valid aws account id.",
+					),
+				},
+			},
+			"ou_ids": schema.ListAttribute{
+				MarkdownDescription: "The ids of the OUs containing the account, ordered from the account's OU up to the organization root.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"approvers": schema.ListNestedAttribute{
+				MarkdownDescription: "The approver groups of the account along with the approvers policy each one came from, ordered from the account up to the organization root. A group approving at several levels is listed once per policy.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							MarkdownDescription: "The id of the approver group.",
+							Computed:            true,
+						},
+						"group_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the approver group.",
+							Computed:            true,
+						},
+						"source_id": schema.StringAttribute{
+							MarkdownDescription: "The account id or OU id of the approvers policy the group came from.",
+							Computed:            true,
+						},
+						"source_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the account or OU of the approvers policy the group came from.",
+							Computed:            true,
+						},
+						"source_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the approvers policy the group came from, either `Account` or `OU`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"group_ids": schema.SetAttribute{
+				MarkdownDescription: "The distinct ids of all approver groups of the account. This is empty when no approvers policy applies to the account.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EffectiveApproversDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EffectiveApproversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EffectiveApproversModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountId := data.AccountId.ValueString()
+
+	ouPath, err := accountOUPath(ctx, d.client, accountId)
+
+	var notFound *awsteam.NotFoundError
+	if errors.As(err, &notFound) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("account_id"),
+			"Account Not Found",
+			fmt.Sprintf("Unable to resolve the approvers of account %s, as it is not in any OU visible to AWS TEAM, so the OU approver policies that apply to it are unknown.", accountId),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the OUs of account %s, got error: %s", accountId, err))
+		return
+	}
+
+	// Policies are checked from the account up to the root.
+	ids := []string{accountId}
+	var ouIds []string
+
+	for i := len(ouPath) - 1; i >= 0; i-- {
+		ids = append(ids, ptr.ToString(ouPath[i].Id))
+		ouIds = append(ouIds, ptr.ToString(ouPath[i].Id))
+	}
+
+	var policies []*awsteam.Approvers
+
+	for _, id := range ids {
+		out, err := d.client.GetApprovers(ctx, &awsteam.GetApproversInput{Id: ptr.String(id)})
+
+		if errors.As(err, &notFound) {
+			continue
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read approvers %s, got error: %s", id, err))
+			return
+		}
+
+		policies = append(policies, out.Approvers)
+	}
+
+	resp.Diagnostics.Append(data.flatten(ctx, ouIds, policies)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read effective approvers data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *EffectiveApproversModel) flatten(ctx context.Context, ouIds []string, policies []*awsteam.Approvers) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ouIdsList, diag := types.ListValueFrom(ctx, types.StringType, ouIds)
+	diags.Append(diag...)
+
+	approversList, diag := flattenEffectiveApprovers(policies)
+	diags.Append(diag...)
+
+	groupIds := []string{}
+	seen := map[string]bool{}
+
+	for _, policy := range policies {
+		for _, groupId := range policy.GroupIds {
+			if !seen[ptr.ToString(groupId)] {
+				seen[ptr.ToString(groupId)] = true
+				groupIds = append(groupIds, ptr.ToString(groupId))
+			}
+		}
+	}
+
+	groupIdsSet, diag := types.SetValueFrom(ctx, types.StringType, groupIds)
+	diags.Append(diag...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = d.AccountId
+	d.OUIds = ouIdsList
+	d.Approvers = approversList
+	d.GroupIds = groupIdsSet
+
+	return diags
+}
+
+func flattenEffectiveApprovers(policies []*awsteam.Approvers) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: effectiveApproversAttrTypes}
+	elems := []attr.Value{}
+
+	for _, policy := range policies {
+		for i, groupId := range policy.GroupIds {
+			// Group names are stored in the same order as the group ids.
+			groupName := types.StringNull()
+
+			if len(policy.Approvers) == len(policy.GroupIds) {
+				groupName = types.StringPointerValue(policy.Approvers[i])
+			}
+
+			obj := map[string]attr.Value{
+				"group_id":    types.StringPointerValue(groupId),
+				"group_name":  groupName,
+				"source_id":   types.StringPointerValue(policy.Id),
+				"source_name": types.StringPointerValue(policy.Name),
+				"source_type": types.StringPointerValue(policy.Type),
+			}
+			objVal, d := types.ObjectValue(effectiveApproversAttrTypes, obj)
+			diags.Append(d...)
+
+			elems = append(elems, objVal)
+		}
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEffectiveApproversDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_effective_approvers.test"
+
+	// This environment variable should be set to the id of an OU expected to be returned.
+	expectedOUIdVar := "AWSTEAM_TESTS_EXPECTED_OU_ID"
+	expectedOUId := os.Getenv(expectedOUIdVar)
+	if expectedOUId == "" {
+		t.Skipf("Skipping Effective Approvers Tests, Environment variable %s is not set.", expectedOUIdVar)
+	}
+
+	// This environment variable should be set to the id of an account in the OU provided, directly or in a nested OU.
+	expectedOUAccountIdVar := "AWSTEAM_TESTS_EXPECTED_OU_ACCOUNT_ID"
+	expectedOUAccountId := os.Getenv(expectedOUAccountIdVar)
+	if expectedOUAccountId == "" {
+		t.Skipf("Skipping Effective Approvers Tests, Environment variable %s is not set.", expectedOUAccountIdVar)
+	}
+
+	accountName := gofakeit.BS()
+	accountApprover := gofakeit.Email()
+	accountGroupId := gofakeit.UUID()
+	ouName := gofakeit.BS()
+	ouApprover := gofakeit.Email()
+	ouGroupId := gofakeit.UUID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectiveApproversDataSourceConfig(expectedOUAccountId, accountName, accountApprover, accountGroupId, expectedOUId, ouName, ouApprover, ouGroupId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", expectedOUAccountId),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "ou_ids.*", expectedOUId),
+					resource.TestCheckResourceAttr(dataSourceName, "approvers.0.group_id", accountGroupId),
+					resource.TestCheckResourceAttr(dataSourceName, "approvers.0.group_name", accountApprover),
+					resource.TestCheckResourceAttr(dataSourceName, "approvers.0.source_id", expectedOUAccountId),
+					resource.TestCheckResourceAttr(dataSourceName, "approvers.0.source_type", "Account"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "approvers.*",
+						map[string]string{
+							"group_id":    ouGroupId,
+							"group_name":  ouApprover,
+							"source_id":   expectedOUId,
+							"source_name": ouName,
+							"source_type": "OU",
+						}),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "group_ids.*", accountGroupId),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "group_ids.*", ouGroupId),
+				),
+			},
+		},
+	})
+}
+
+func testAccEffectiveApproversDataSourceConfig(accountId, accountName, accountApprover, accountGroupId, ouId, ouName, ouApprover, ouGroupId string) string {
+	return testAccApproversAccountResourceConfig(accountId, accountName, accountApprover, accountGroupId) +
+		testAccApproversOUResourceConfig(ouId, ouName, ouApprover, ouGroupId) + fmt.Sprintf(`
+
+data "awsteam_effective_approvers" "test" {
+  account_id = %q
+
+  depends_on = [awsteam_approvers_account.test, awsteam_approvers_ou.test]
+}
+`, accountId)
+}
@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	effectiveEligibilityAttrTypes = map[string]attr.Type{
+		"account_id":        types.StringType,
+		"account_name":      types.StringType,
+		"permission_arn":    types.StringType,
+		"permission_name":   types.StringType,
+		"max_duration":      types.Int64Type,
+		"approval_required": types.BoolType,
+		"source_ids":        types.SetType{ElemType: types.StringType},
+	}
+)
+var _ datasource.DataSource = &EffectiveEligibilityDataSource{}
+
+func NewEffectiveEligibilityDataSource() datasource.DataSource {
+	return &EffectiveEligibilityDataSource{}
+}
+
+type EffectiveEligibilityDataSource struct {
+	client *awsteam.Client
+}
+
+type EffectiveEligibilityModel struct {
+	Id            types.String `tfsdk:"id"`
+	UserId        types.String `tfsdk:"user_id"`
+	GroupIds      types.Set    `tfsdk:"group_ids"`
+	Eligibilities types.List   `tfsdk:"eligibilities"`
+}
+
+// effectiveEligibility is the merged eligibility of a user for a permission set on an account, from
+// the policies granting it with the same duration and approval setting.
+type effectiveEligibility struct {
+	AccountId        string
+	AccountName      *string
+	PermissionArn    string
+	PermissionName   *string
+	MaxDuration      int64
+	ApprovalRequired bool
+	SourceIds        []string
+}
+
+// effectiveEligibilityKey identifies the policies that can be merged into one effectiveEligibility.
+type effectiveEligibilityKey struct {
+	accountId        string
+	permissionArn    string
+	duration         int64
+	approvalRequired bool
+}
+
+func (d *EffectiveEligibilityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_eligibility"
+}
+
+func (d *EffectiveEligibilityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source resolving what a user is eligible for from their own eligibility policy and those of the groups they are a member of",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The IAM Identity Center user id.",
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The IAM Identity Center user id to resolve the eligibility of.",
+				Required:            true,
+			},
+			"group_ids": schema.SetAttribute{
+				MarkdownDescription: "The ids of the groups the user is a member of.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"eligibilities": schema.ListNestedAttribute{
+				MarkdownDescription: "The permission sets the user is eligible for on each account, sorted by account id, permission set ARN, duration and approval. OUs in eligibility policies are expanded into the accounts they contain, including accounts in nested OUs. Policies granting the same permission set on an account are only merged when their duration and approval settings match, so each entry describes access that a single policy grants.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							MarkdownDescription: "The AWS account id.",
+							Computed:            true,
+						},
+						"account_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the AWS account.",
+							Computed:            true,
+						},
+						"permission_arn": schema.StringAttribute{
+							MarkdownDescription: "The ARN of the permission set.",
+							Computed:            true,
+						},
+						"permission_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the permission set.",
+							Computed:            true,
+						},
+						"max_duration": schema.Int64Attribute{
+							MarkdownDescription: "The longest elevated access request duration in hours granted by the source policies.",
+							Computed:            true,
+						},
+						"approval_required": schema.BoolAttribute{
+							MarkdownDescription: "Whether the source policies require approval.",
+							Computed:            true,
+						},
+						"source_ids": schema.SetAttribute{
+							MarkdownDescription: "The ids of the eligibility policies granting access, which are the user id or the ids of the user's groups.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EffectiveEligibilityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EffectiveEligibilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EffectiveEligibilityModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userId := data.UserId.ValueString()
+
+	memberships, err := d.client.GetGroupMemberships(ctx, &awsteam.GetGroupMembershipsInput{UserId: ptr.String(userId)})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group memberships of user %s, got error: %s", userId, err))
+		return
+	}
+
+	var policies []*awsteam.Eligibility
+
+	for _, id := range append([]*string{ptr.String(userId)}, memberships.GroupIds...) {
+		out, err := d.client.GetEligibility(ctx, &awsteam.GetEligibilityInput{Id: id})
+
+		var notFound *awsteam.NotFoundError
+		if errors.As(err, &notFound) {
+			continue
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read eligibility %s, got error: %s", ptr.ToString(id), err))
+			return
+		}
+
+		policies = append(policies, out.Eligibility)
+	}
+
+	eligibilities, err := d.resolve(ctx, policies)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to expand OUs into accounts, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(ctx, memberships.GroupIds, eligibilities)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read effective eligibility data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolve expands the OUs of each policy into accounts and merges the policies into one eligibility
+// per account, permission set, duration and approval setting. Policies with different settings are
+// kept apart so an entry never pairs the duration of one policy with the approval of another.
+func (d *EffectiveEligibilityDataSource) resolve(ctx context.Context, policies []*awsteam.Eligibility) ([]*effectiveEligibility, error) {
+	var ous []awsteam.OU
+	cache := map[string][]*awsteam.Account{}
+	merged := map[effectiveEligibilityKey]*effectiveEligibility{}
+
+	for _, policy := range policies {
+		accounts := make([]*awsteam.Account, 0, len(policy.Accounts))
+
+		for _, account := range policy.Accounts {
+			accounts = append(accounts, &awsteam.Account{Id: account.Id, Name: account.Name})
+		}
+
+		if len(policy.OUs) > 0 && ous == nil {
+			out, err := d.client.GetOUs(ctx, &awsteam.GetOUsInput{})
+
+			if err != nil {
+				return nil, err
+			}
+
+			ous = out.OUs
+		}
+
+		for _, eligibilityOU := range policy.OUs {
+			ou := awsteam.FindOUById(ous, ptr.ToString(eligibilityOU.Id))
+
+			// OUs that have since been removed from the organization no longer grant access.
+			if ou == nil {
+				continue
+			}
+
+			ouAccounts, err := ouTreeAccounts(ctx, d.client, ou, cache)
+
+			if err != nil {
+				return nil, err
+			}
+
+			accounts = append(accounts, ouAccounts...)
+		}
+
+		for _, account := range accounts {
+			for _, permission := range policy.Permissions {
+				key := effectiveEligibilityKey{
+					accountId:        ptr.ToString(account.Id),
+					permissionArn:    ptr.ToString(permission.Id),
+					duration:         ptr.ToInt64(policy.Duration),
+					approvalRequired: ptr.ToBool(policy.ApprovalRequired),
+				}
+				eligibility, ok := merged[key]
+
+				if !ok {
+					eligibility = &effectiveEligibility{
+						AccountId:        key.accountId,
+						AccountName:      account.Name,
+						PermissionArn:    key.permissionArn,
+						PermissionName:   permission.Name,
+						MaxDuration:      key.duration,
+						ApprovalRequired: key.approvalRequired,
+					}
+					merged[key] = eligibility
+				}
+
+				eligibility.SourceIds = appendUnique(eligibility.SourceIds, ptr.ToString(policy.Id))
+			}
+		}
+	}
+
+	eligibilities := make([]*effectiveEligibility, 0, len(merged))
+
+	for _, eligibility := range merged {
+		eligibilities = append(eligibilities, eligibility)
+	}
+
+	sort.Slice(eligibilities, func(i, j int) bool {
+		if eligibilities[i].AccountId != eligibilities[j].AccountId {
+			return eligibilities[i].AccountId < eligibilities[j].AccountId
+		}
+
+		if eligibilities[i].PermissionArn != eligibilities[j].PermissionArn {
+			return eligibilities[i].PermissionArn < eligibilities[j].PermissionArn
+		}
+
+		if eligibilities[i].MaxDuration != eligibilities[j].MaxDuration {
+			return eligibilities[i].MaxDuration < eligibilities[j].MaxDuration
+		}
+
+		return eligibilities[i].ApprovalRequired && !eligibilities[j].ApprovalRequired
+	})
+
+	return eligibilities, nil
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
+
+func (d *EffectiveEligibilityModel) flatten(ctx context.Context, groupIds []*string, eligibilities []*effectiveEligibility) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupIdsSet, diag := types.SetValueFrom(ctx, types.StringType, groupIds)
+	diags.Append(diag...)
+
+	eligibilitiesList, diag := flattenEffectiveEligibilities(ctx, eligibilities)
+	diags.Append(diag...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = d.UserId
+	d.GroupIds = groupIdsSet
+	d.Eligibilities = eligibilitiesList
+
+	return diags
+}
+
+func flattenEffectiveEligibilities(ctx context.Context, eligibilities []*effectiveEligibility) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: effectiveEligibilityAttrTypes}
+	elems := []attr.Value{}
+
+	for _, eligibility := range eligibilities {
+		sourceIdsSet, d := types.SetValueFrom(ctx, types.StringType, eligibility.SourceIds)
+		diags.Append(d...)
+
+		obj := map[string]attr.Value{
+			"account_id":        types.StringValue(eligibility.AccountId),
+			"account_name":      types.StringPointerValue(eligibility.AccountName),
+			"permission_arn":    types.StringValue(eligibility.PermissionArn),
+			"permission_name":   types.StringPointerValue(eligibility.PermissionName),
+			"max_duration":      types.Int64Value(eligibility.MaxDuration),
+			"approval_required": types.BoolValue(eligibility.ApprovalRequired),
+			"source_ids":        sourceIdsSet,
+		}
+		objVal, d := types.ObjectValue(effectiveEligibilityAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(elemType), diags
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
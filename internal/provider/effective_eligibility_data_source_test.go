@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEffectiveEligibilityDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_effective_eligibility.test"
+
+	// This environment variable should be set to the id of an IAM Identity Center user.
+	userIdVar := "AWSTEAM_TESTS_USER_ID"
+	userId := os.Getenv(userIdVar)
+	if userId == "" {
+		t.Skipf("Skipping Effective Eligibility Tests, Environment variable %s is not set.", userIdVar)
+	}
+
+	// This environment variable should be set to the id of a group the user provided is a member of.
+	groupIdVar := "AWSTEAM_TESTS_USER_GROUP_ID"
+	groupId := os.Getenv(groupIdVar)
+	if groupId == "" {
+		t.Skipf("Skipping Effective Eligibility Tests, Environment variable %s is not set.", groupIdVar)
+	}
+
+	user := gofakeit.Email()
+	group := gofakeit.Email()
+	ticketNo := gofakeit.BS()
+	accountId := gofakeit.DigitN(12)
+	accountName := gofakeit.BS()
+	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
+	ouName := gofakeit.BS()
+	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
+	permissionName := "elevated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEligibilityUserResourceConfig(user, userId, true, "2", ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName) +
+					testAccEligibilityGroupResourceConfig(group, groupId, false, "5", ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName) +
+					testAccEffectiveEligibilityDataSourceConfig(userId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", userId),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "group_ids.*", groupId),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "eligibilities.*",
+						map[string]string{
+							"account_id":        accountId,
+							"account_name":      accountName,
+							"permission_arn":    permissionArn,
+							"permission_name":   permissionName,
+							"max_duration":      "2",
+							"approval_required": "true",
+							"source_ids.#":      "1",
+							"source_ids.0":      userId,
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "eligibilities.*",
+						map[string]string{
+							"account_id":        accountId,
+							"account_name":      accountName,
+							"permission_arn":    permissionArn,
+							"permission_name":   permissionName,
+							"max_duration":      "5",
+							"approval_required": "false",
+							"source_ids.#":      "1",
+							"source_ids.0":      groupId,
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccEffectiveEligibilityDataSourceConfig(userId string) string {
+	return fmt.Sprintf(`
+
+data "awsteam_effective_eligibility" "test" {
+  user_id = %q
+
+  depends_on = [awsteam_eligibility_user.test, awsteam_eligibility_group.test]
+}
+`, userId)
+}
+
+func TestEffectiveEligibilityResolve_conflictingPolicies(t *testing.T) {
+	account := &awsteam.EligibilityAccount{Id: ptr.String("111111111111"), Name: ptr.String("production")}
+	permission := &awsteam.EligibilityPermission{Id: ptr.String("arn:aws:sso:::permissionSet/ssoins-1/ps-1"), Name: ptr.String("admin")}
+
+	policies := []*awsteam.Eligibility{
+		// A long duration that needs approval.
+		{Id: ptr.String("user"), Accounts: []*awsteam.EligibilityAccount{account}, Permissions: []*awsteam.EligibilityPermission{permission}, Duration: ptr.Int64(12), ApprovalRequired: ptr.Bool(true)},
+		// A short duration without approval.
+		{Id: ptr.String("group-a"), Accounts: []*awsteam.EligibilityAccount{account}, Permissions: []*awsteam.EligibilityPermission{permission}, Duration: ptr.Int64(1), ApprovalRequired: ptr.Bool(false)},
+		// The same settings as the first policy, so it is merged with it.
+		{Id: ptr.String("group-b"), Accounts: []*awsteam.EligibilityAccount{account}, Permissions: []*awsteam.EligibilityPermission{permission}, Duration: ptr.Int64(12), ApprovalRequired: ptr.Bool(true)},
+	}
+
+	// Policies without OUs are resolved without calling the API.
+	d := &EffectiveEligibilityDataSource{}
+	got, err := d.resolve(context.Background(), policies)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*effectiveEligibility{
+		{AccountId: "111111111111", AccountName: account.Name, PermissionArn: *permission.Id, PermissionName: permission.Name, MaxDuration: 1, ApprovalRequired: false, SourceIds: []string{"group-a"}},
+		{AccountId: "111111111111", AccountName: account.Name, PermissionArn: *permission.Id, PermissionName: permission.Name, MaxDuration: 12, ApprovalRequired: true, SourceIds: []string{"user", "group-b"}},
+	}
+
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d eligibilities, got %d", len(expected), len(got))
+	}
+
+	for i := range expected {
+		if !reflect.DeepEqual(got[i], expected[i]) {
+			t.Errorf("eligibility %d: expected %+v, got %+v", i, *expected[i], *got[i])
+		}
+	}
+}
@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/names"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	eligibilitiesAttrTypes = map[string]attr.Type{
+		"id":                    types.StringType,
+		"name":                  types.StringType,
+		"type":                  types.StringType,
+		names.AttrAccountSet:    types.SetType{ElemType: types.ObjectType{AttrTypes: eligibilityAccountAttrTypes}},
+		names.AttrOUSet:         types.SetType{ElemType: types.ObjectType{AttrTypes: eligibilityOUAttrTypes}},
+		names.AttrPermissionSet: types.SetType{ElemType: types.ObjectType{AttrTypes: eligibilityPermissionAttrTypes}},
+		"ticket_no":             types.StringType,
+		"approval_required":     types.BoolType,
+		"duration":              types.Int64Type,
+		names.AttrModifiedBy:    types.StringType,
+		names.AttrCreatedAt:     types.StringType,
+		names.AttrUpdatedAt:     types.StringType,
+	}
+)
+var _ datasource.DataSource = &EligibilitiesDataSource{}
+
+func NewEligibilitiesDataSource() datasource.DataSource {
+	return &EligibilitiesDataSource{}
+}
+
+type EligibilitiesDataSource struct {
+	client *awsteam.Client
+}
+
+type EligibilitiesModel struct {
+	Id            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	NameRegex     types.String `tfsdk:"name_regex"`
+	AccountId     types.String `tfsdk:"account_id"`
+	OUId          types.String `tfsdk:"ou_id"`
+	PermissionArn types.String `tfsdk:"permission_arn"`
+	Eligibilities types.List   `tfsdk:"eligibilities"`
+}
+
+type eligibilitiesFilter struct {
+	NameRegex     *regexp.Regexp
+	AccountId     *string
+	AccountOUIds  map[string]bool // Ids of the OUs containing the account, from the root down
+	OUId          *string
+	PermissionArn *string
+}
+
+func (d *EligibilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eligibilities"
+}
+
+func (d *EligibilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the eligibility policies of an AWS TEAM deployment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Eligibilities Identifier. This is a static value of `eligibilities`.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return eligibility policies of this type, either `User` or `Group`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(EligibilityUserType, EligibilityGroupType),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression used to filter eligibility policies by user or group name.",
+				Optional:            true,
+				Validators: []validator.String{
+					ValidRegex(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Only return eligibility policies that grant access to this AWS account id, either by listing it in `accounts` or by listing an OU that contains it, directly or through a nested OU.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^\d{12}$`),
+						"value must be a valid aws account id.",
+					),
+				},
+			},
+			"ou_id": schema.StringAttribute{
+				MarkdownDescription: "Only return eligibility policies that list this OU id in `ous`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$`),
+						"value must be a valid ou id.",
+					),
+				},
+			},
+			"permission_arn": schema.StringAttribute{
+				MarkdownDescription: "Only return eligibility policies that list this permission set ARN in `permissions`.",
+				Optional:            true,
+			},
+			"eligibilities": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of eligibility policies, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The id of the user or group the eligibility policy applies to.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the user or group the eligibility policy applies to.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the eligibility policy, either `User` or `Group`.",
+							Computed:            true,
+						},
+						"approval_required": schema.BoolAttribute{
+							MarkdownDescription: "Determines if approval is required for elevated access",
+							Computed:            true,
+						},
+						"duration": schema.Int64Attribute{
+							MarkdownDescription: "The maximum elevated access request duration in hours.",
+							Computed:            true,
+						},
+						"ticket_no": schema.StringAttribute{
+							MarkdownDescription: "The Change Management system ticket system number.",
+							Computed:            true,
+						},
+						names.AttrAccountSet:    AccountDataSourceAttributeSet(),
+						names.AttrOUSet:         OUDataSourceAttributeSet(),
+						names.AttrPermissionSet: PermissionDataSourceAttributeSet(),
+						names.AttrModifiedBy: schema.StringAttribute{
+							MarkdownDescription: "The user to last modify the item",
+							Computed:            true,
+						},
+						names.AttrCreatedAt: schema.StringAttribute{
+							MarkdownDescription: "The date and time that the item was created",
+							Computed:            true,
+						},
+						names.AttrUpdatedAt: schema.StringAttribute{
+							MarkdownDescription: "The date and time of the last time the item was updated",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EligibilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EligibilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EligibilitiesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.ListEligibilitiesInput{
+		Type: data.Type.ValueStringPointer(),
+	}
+
+	var eligibilities []*awsteam.Eligibility
+	paginator := awsteam.NewListEligibilitiesPaginator(d.client, in)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list eligibilities, got error: %s", err))
+			return
+		}
+
+		eligibilities = append(eligibilities, page.Eligibilities...)
+	}
+
+	filter := eligibilitiesFilter{
+		AccountId:     data.AccountId.ValueStringPointer(),
+		OUId:          data.OUId.ValueStringPointer(),
+		PermissionArn: data.PermissionArn.ValueStringPointer(),
+	}
+
+	if filter.AccountId != nil {
+		ouPath, err := accountOUPath(ctx, d.client, *filter.AccountId)
+
+		var notFound *awsteam.NotFoundError
+		if errors.As(err, &notFound) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("account_id"),
+				"Account Not Found",
+				fmt.Sprintf("Account %s is not in any OU visible to AWS TEAM, so only eligibility policies listing it directly are returned.", *filter.AccountId),
+			)
+		} else if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the OUs of account %s, got error: %s", *filter.AccountId, err))
+			return
+		}
+
+		filter.AccountOUIds = map[string]bool{}
+
+		for _, ou := range ouPath {
+			filter.AccountOUIds[ptr.ToString(ou.Id)] = true
+		}
+	}
+
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Unable to compile %q, got error: %s", data.NameRegex.ValueString(), err),
+			)
+			return
+		}
+
+		filter.NameRegex = nameRegex
+	}
+
+	eligibilities = filterEligibilities(eligibilities, filter)
+
+	sort.SliceStable(eligibilities, func(i, j int) bool {
+		if ptr.ToString(eligibilities[i].Name) != ptr.ToString(eligibilities[j].Name) {
+			return ptr.ToString(eligibilities[i].Name) < ptr.ToString(eligibilities[j].Name)
+		}
+
+		return ptr.ToString(eligibilities[i].Id) < ptr.ToString(eligibilities[j].Id)
+	})
+
+	resp.Diagnostics.Append(data.flatten(eligibilities)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read eligibilities data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *EligibilitiesModel) flatten(eligibilities []*awsteam.Eligibility) diag.Diagnostics {
+	eligibilitiesList, diags := flattenEligibilities(eligibilities)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("eligibilities")
+	d.Eligibilities = eligibilitiesList
+
+	return diags
+}
+
+func filterEligibilities(eligibilities []*awsteam.Eligibility, filter eligibilitiesFilter) []*awsteam.Eligibility {
+	var filtered []*awsteam.Eligibility
+
+	for _, eligibility := range eligibilities {
+		if filter.NameRegex != nil && !filter.NameRegex.MatchString(ptr.ToString(eligibility.Name)) {
+			continue
+		}
+
+		if filter.AccountId != nil && !eligibilityHasAccount(eligibility, *filter.AccountId, filter.AccountOUIds) {
+			continue
+		}
+
+		if filter.OUId != nil && !eligibilityHasOU(eligibility, *filter.OUId) {
+			continue
+		}
+
+		if filter.PermissionArn != nil && !eligibilityHasPermission(eligibility, *filter.PermissionArn) {
+			continue
+		}
+
+		filtered = append(filtered, eligibility)
+	}
+
+	return filtered
+}
+
+// eligibilityHasAccount reports whether the eligibility lists the account, or one of the OUs in
+// ouIds that contain it.
+func eligibilityHasAccount(eligibility *awsteam.Eligibility, id string, ouIds map[string]bool) bool {
+	for _, account := range eligibility.Accounts {
+		if ptr.ToString(account.Id) == id {
+			return true
+		}
+	}
+
+	for _, ou := range eligibility.OUs {
+		if ouIds[ptr.ToString(ou.Id)] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func eligibilityHasOU(eligibility *awsteam.Eligibility, id string) bool {
+	for _, ou := range eligibility.OUs {
+		if ptr.ToString(ou.Id) == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func eligibilityHasPermission(eligibility *awsteam.Eligibility, arn string) bool {
+	for _, permission := range eligibility.Permissions {
+		if ptr.ToString(permission.Id) == arn {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenEligibilities(apiObject []*awsteam.Eligibility) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: eligibilitiesAttrTypes}
+	elems := []attr.Value{}
+
+	for _, eligibility := range apiObject {
+		accountsSet, d := flattenEligibilityAccounts(eligibility.Accounts)
+		diags.Append(d...)
+
+		ousSet, d := flattenEligibilityOUs(eligibility.OUs)
+		diags.Append(d...)
+
+		permissionsSet, d := flattenEligibilityPermissions(eligibility.Permissions)
+		diags.Append(d...)
+
+		obj := map[string]attr.Value{
+			"id":                    types.StringPointerValue(eligibility.Id),
+			"name":                  types.StringPointerValue(eligibility.Name),
+			"type":                  types.StringPointerValue(eligibility.Type),
+			names.AttrAccountSet:    accountsSet,
+			names.AttrOUSet:         ousSet,
+			names.AttrPermissionSet: permissionsSet,
+			"ticket_no":             types.StringPointerValue(eligibility.TicketNo),
+			"approval_required":     types.BoolPointerValue(eligibility.ApprovalRequired),
+			"duration":              types.Int64PointerValue(eligibility.Duration),
+			names.AttrModifiedBy:    types.StringPointerValue(eligibility.ModifiedBy),
+			names.AttrCreatedAt:     types.StringPointerValue(eligibility.CreatedAt),
+			names.AttrUpdatedAt:     types.StringPointerValue(eligibility.UpdatedAt),
+		}
+		objVal, d := types.ObjectValue(eligibilitiesAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(elemType), diags
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
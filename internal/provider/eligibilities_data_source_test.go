@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEligibilitiesDataSource_basic(t *testing.T) {
+	resourceName := "awsteam_eligibility_group.test"
+	dataSourceName := "data.awsteam_eligibilities.test"
+	group := gofakeit.Email()
+	groupId := gofakeit.UUID()
+	duration := fmt.Sprint(gofakeit.Number(1, 10))
+	ticketNo := gofakeit.BS()
+	accountId := gofakeit.DigitN(12)
+	accountName := gofakeit.BS()
+	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
+	ouName := gofakeit.BS()
+	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
+	permissionName := "elevated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEligibilitiesDataSourceConfig(group, groupId, duration, ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "eligibilities"),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "eligibilities.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.0.name", group),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.0.type", "Group"),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.0.accounts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.0.ous.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.0.permissions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEligibilitiesDataSource_ouAccount(t *testing.T) {
+	resourceName := "awsteam_eligibility_group.test"
+	dataSourceName := "data.awsteam_eligibilities.test"
+
+	// This environment variable should be set to the id of an OU expected to be returned.
+	expectedOUIdVar := "AWSTEAM_TESTS_EXPECTED_OU_ID"
+	expectedOUId := os.Getenv(expectedOUIdVar)
+	if expectedOUId == "" {
+		t.Skipf("Skipping Eligibilities Tests, Environment variable %s is not set.", expectedOUIdVar)
+	}
+
+	// This environment variable should be set to the id of an account in the OU provided, directly or in a nested OU.
+	expectedOUAccountIdVar := "AWSTEAM_TESTS_EXPECTED_OU_ACCOUNT_ID"
+	expectedOUAccountId := os.Getenv(expectedOUAccountIdVar)
+	if expectedOUAccountId == "" {
+		t.Skipf("Skipping Eligibilities Tests, Environment variable %s is not set.", expectedOUAccountIdVar)
+	}
+
+	group := gofakeit.Email()
+	groupId := gofakeit.UUID()
+	duration := fmt.Sprint(gofakeit.Number(1, 10))
+	ticketNo := gofakeit.BS()
+	accountId := gofakeit.DigitN(12) // an unrelated account so only the OU grants the expected account
+	accountName := gofakeit.BS()
+	ouName := gofakeit.BS()
+	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
+	permissionName := "elevated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEligibilitiesDataSourceOUAccountConfig(group, groupId, duration, ticketNo, accountId, accountName, expectedOUId, ouName, permissionArn, permissionName, expectedOUAccountId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "eligibilities.0.id", resourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "eligibilities.0.ous.*",
+						map[string]string{
+							"ou_id": expectedOUId,
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccEligibilitiesDataSourceConfig(group, groupId, duration, ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName string) string {
+	return testAccEligibilityGroupResourceConfig(group, groupId, true, duration, ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName) + fmt.Sprintf(`
+
+data "awsteam_eligibilities" "test" {
+  type           = "Group"
+  name_regex     = %q
+  account_id     = %q
+  ou_id          = %q
+  permission_arn = %q
+
+  depends_on = [awsteam_eligibility_group.test]
+}
+`, "^"+regexp.QuoteMeta(group)+"$", accountId, ouId, permissionArn)
+}
+
+func testAccEligibilitiesDataSourceOUAccountConfig(group, groupId, duration, ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName, ouAccountId string) string {
+	return testAccEligibilityGroupResourceConfig(group, groupId, true, duration, ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName) + fmt.Sprintf(`
+
+data "awsteam_eligibilities" "test" {
+  type       = "Group"
+  name_regex = %q
+  account_id = %q
+
+  depends_on = [awsteam_eligibility_group.test]
+}
+`, "^"+regexp.QuoteMeta(group)+"$", ouAccountId)
+}
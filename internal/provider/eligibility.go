@@ -5,6 +5,7 @@ import (
 	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -128,6 +129,66 @@ func PermissionAttributeSet() schema.SetNestedAttribute {
 	}
 }
 
+// AccountDataSourceAttributeSet is the read only form of AccountAttributeSet for data sources.
+func AccountDataSourceAttributeSet() dsschema.SetNestedAttribute {
+	return dsschema.SetNestedAttribute{
+		MarkdownDescription: "A list of AWS accounts the eligibility applies to.",
+		Computed:            true,
+		NestedObject: dsschema.NestedAttributeObject{
+			Attributes: map[string]dsschema.Attribute{
+				"account_id": dsschema.StringAttribute{
+					MarkdownDescription: "The AWS account id the eligibility policy is applied to.",
+					Computed:            true,
+				},
+				"account_name": dsschema.StringAttribute{
+					MarkdownDescription: "Name of the AWS account the eligibility policy is applied to.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// OUDataSourceAttributeSet is the read only form of OUAttributeSet for data sources.
+func OUDataSourceAttributeSet() dsschema.SetNestedAttribute {
+	return dsschema.SetNestedAttribute{
+		MarkdownDescription: "A list of AWS OUs the eligibility applies to.",
+		Computed:            true,
+		NestedObject: dsschema.NestedAttributeObject{
+			Attributes: map[string]dsschema.Attribute{
+				"ou_id": dsschema.StringAttribute{
+					MarkdownDescription: "The id of the OU the eligibility policy is applied to.",
+					Computed:            true,
+				},
+				"ou_name": dsschema.StringAttribute{
+					MarkdownDescription: "Name of the OU the eligibility policy is applied to.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// PermissionDataSourceAttributeSet is the read only form of PermissionAttributeSet for data sources.
+func PermissionDataSourceAttributeSet() dsschema.SetNestedAttribute {
+	return dsschema.SetNestedAttribute{
+		MarkdownDescription: "A list of AWS permission sets for the eligibility policy.",
+		Computed:            true,
+		NestedObject: dsschema.NestedAttributeObject{
+			Attributes: map[string]dsschema.Attribute{
+				"permission_arn": dsschema.StringAttribute{
+					MarkdownDescription: "The ARN of the permission set.",
+					Computed:            true,
+				},
+				"permission_name": dsschema.StringAttribute{
+					MarkdownDescription: "Name of the permission set.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
 func expandEligibilityAccounts(raw []*EligibilityAccount) []*awsteam.EligibilityAccount {
 	var accounts []*awsteam.EligibilityAccount
 
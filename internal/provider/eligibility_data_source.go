@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/names"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &EligibilityDataSource{}
+
+func NewEligibilityDataSource() datasource.DataSource {
+	return &EligibilityDataSource{}
+}
+
+type EligibilityDataSource struct {
+	client *awsteam.Client
+}
+
+type EligibilityModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Accounts         types.Set    `tfsdk:"accounts"`
+	OUs              types.Set    `tfsdk:"ous"`
+	Permissions      types.Set    `tfsdk:"permissions"`
+	TicketNo         types.String `tfsdk:"ticket_no"`
+	ApprovalRequired types.Bool   `tfsdk:"approval_required"`
+	Duration         types.Int64  `tfsdk:"duration"`
+	ModifiedBy       types.String `tfsdk:"modified_by"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+}
+
+func (d *EligibilityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eligibility"
+}
+
+func (d *EligibilityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for an existing AWS TEAM eligibility policy, looked up by id or by name and type",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the user or group the eligibility policy applies to. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user or group the eligibility policy applies to. Requires `type` to be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("type")),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the eligibility policy, either `User` or `Group`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(EligibilityUserType, EligibilityGroupType),
+				},
+			},
+			"approval_required": schema.BoolAttribute{
+				MarkdownDescription: "Determines if approval is required for elevated access",
+				Computed:            true,
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "The maximum elevated access request duration in hours.",
+				Computed:            true,
+			},
+			"ticket_no": schema.StringAttribute{
+				MarkdownDescription: "The Change Management system ticket system number.",
+				Computed:            true,
+			},
+			names.AttrAccountSet:    AccountDataSourceAttributeSet(),
+			names.AttrOUSet:         OUDataSourceAttributeSet(),
+			names.AttrPermissionSet: PermissionDataSourceAttributeSet(),
+			names.AttrModifiedBy: schema.StringAttribute{
+				MarkdownDescription: "The user to last modify the item",
+				Computed:            true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				MarkdownDescription: "The date and time that the item was created",
+				Computed:            true,
+			},
+			names.AttrUpdatedAt: schema.StringAttribute{
+				MarkdownDescription: "The date and time of the last time the item was updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EligibilityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EligibilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EligibilityModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var eligibility *awsteam.Eligibility
+
+	if !data.Id.IsNull() {
+		in := &awsteam.GetEligibilityInput{
+			Id: data.Id.ValueStringPointer(),
+		}
+
+		out, err := d.client.GetEligibility(ctx, in)
+
+		var notFound *awsteam.NotFoundError
+		if errors.As(err, &notFound) {
+			resp.Diagnostics.AddError("Eligibility Not Found", fmt.Sprintf("No eligibility policy with id %q was found.", data.Id.ValueString()))
+			return
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read eligibility, got error: %s", err))
+			return
+		}
+
+		eligibility = out.Eligibility
+	} else {
+		in := &awsteam.ListEligibilitiesInput{
+			Name: data.Name.ValueStringPointer(),
+			Type: data.Type.ValueStringPointer(),
+		}
+
+		var matches []*awsteam.Eligibility
+		paginator := awsteam.NewListEligibilitiesPaginator(d.client, in)
+
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list eligibilities, got error: %s", err))
+				return
+			}
+
+			matches = append(matches, page.Eligibilities...)
+		}
+
+		lookup := fmt.Sprintf("name %q and type %q", data.Name.ValueString(), data.Type.ValueString())
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError("Eligibility Not Found", fmt.Sprintf("No eligibility policy with %s was found.", lookup))
+			return
+		}
+
+		if len(matches) > 1 {
+			var ids []string
+
+			for _, match := range matches {
+				ids = append(ids, ptr.ToString(match.Id))
+			}
+
+			resp.Diagnostics.AddError("Multiple Eligibilities Found", fmt.Sprintf("%d eligibility policies with %s were found: %v. Look the eligibility up by id instead.", len(matches), lookup, ids))
+			return
+		}
+
+		eligibility = matches[0]
+	}
+
+	resp.Diagnostics.Append(data.flatten(eligibility)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read eligibility data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *EligibilityModel) flatten(out *awsteam.Eligibility) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountsSet, diag := flattenEligibilityAccounts(out.Accounts)
+	diags.Append(diag...)
+
+	ousSet, diag := flattenEligibilityOUs(out.OUs)
+	diags.Append(diag...)
+
+	permissionsSet, diag := flattenEligibilityPermissions(out.Permissions)
+	diags.Append(diag...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringPointerValue(out.Id)
+	d.Name = types.StringPointerValue(out.Name)
+	d.Type = types.StringPointerValue(out.Type)
+	d.Accounts = accountsSet
+	d.OUs = ousSet
+	d.Permissions = permissionsSet
+	d.ApprovalRequired = types.BoolPointerValue(out.ApprovalRequired)
+	d.Duration = types.Int64PointerValue(out.Duration)
+	d.TicketNo = types.StringPointerValue(out.TicketNo)
+	d.ModifiedBy = types.StringPointerValue(out.ModifiedBy)
+	d.CreatedAt = types.StringPointerValue(out.CreatedAt)
+	d.UpdatedAt = types.StringPointerValue(out.UpdatedAt)
+
+	return diags
+}
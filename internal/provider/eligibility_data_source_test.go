@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEligibilityDataSource_basic(t *testing.T) {
+	resourceName := "awsteam_eligibility_group.test"
+	dataSourceByIdName := "data.awsteam_eligibility.by_id"
+	dataSourceByNameName := "data.awsteam_eligibility.by_name"
+	group := gofakeit.Email()
+	groupId := gofakeit.UUID()
+	duration := fmt.Sprint(gofakeit.Number(1, 10))
+	ticketNo := gofakeit.BS()
+	accountId := gofakeit.DigitN(12)
+	accountName := gofakeit.BS()
+	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
+	ouName := gofakeit.BS()
+	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
+	permissionName := "elevated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEligibilityDataSourceConfig(group, groupId, duration, ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceByIdName, "name", group),
+					resource.TestCheckResourceAttr(dataSourceByIdName, "type", "Group"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "approval_required", resourceName, "approval_required"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "duration", resourceName, "duration"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "ticket_no", resourceName, "ticket_no"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceByIdName, "accounts.*",
+						map[string]string{
+							"account_id":   accountId,
+							"account_name": accountName,
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceByIdName, "ous.*",
+						map[string]string{
+							"ou_id":   ouId,
+							"ou_name": ouName,
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceByIdName, "permissions.*",
+						map[string]string{
+							"permission_arn":  permissionArn,
+							"permission_name": permissionName,
+						}),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceByNameName, "accounts.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEligibilityDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEligibilityDataSourceConfig_id(gofakeit.UUID()),
+				ExpectError: regexp.MustCompile(`Eligibility Not Found`),
+			},
+		},
+	})
+}
+
+func testAccEligibilityDataSourceConfig(group, groupId, duration, ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName string) string {
+	return testAccEligibilityGroupResourceConfig(group, groupId, true, duration, ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName) + `
+
+data "awsteam_eligibility" "by_id" {
+  id = awsteam_eligibility_group.test.id
+}
+
+data "awsteam_eligibility" "by_name" {
+  name = awsteam_eligibility_group.test.group_name
+  type = "Group"
+}
+`
+}
+
+func testAccEligibilityDataSourceConfig_id(id string) string {
+	return fmt.Sprintf(`
+data "awsteam_eligibility" "by_id" {
+  id = %q
+}
+`, id)
+}
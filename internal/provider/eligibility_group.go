@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -214,6 +215,13 @@ func (r *EligibilityGroupResource) Read(ctx context.Context, req resource.ReadRe
 
 	out, err := r.client.GetEligibility(ctx, in)
 
+	var notFound *awsteam.NotFoundError
+	if errors.As(err, &notFound) {
+		tflog.Warn(ctx, "Eligibility not found, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read eligibility group policy, got error: %s", err))
 		return
@@ -347,6 +355,11 @@ func (r *EligibilityGroupResource) Delete(ctx context.Context, req resource.Dele
 
 	_, err := r.client.DeleteEligibility(ctx, in)
 
+	var notFound *awsteam.NotFoundError
+	if errors.As(err, &notFound) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete eligibility group, got error: %s", err))
 		return
@@ -22,7 +22,12 @@ func testAccEligibilityResourceExists(ctx context.Context, resourceName string)
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		client := acctest.NewAWSTeamClient(ctx)
+		client, err := acctest.NewAWSTeamClient(ctx)
+
+		if err != nil {
+			return err
+		}
+
 		out, err := client.GetEligibility(ctx, &awsteam.GetEligibilityInput{Id: ptr.String(rs.Primary.ID)})
 
 		if err != nil {
@@ -52,8 +57,13 @@ func testAccEligibilityResourceDisappears(ctx context.Context, resourceName stri
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		client := acctest.NewAWSTeamClient(ctx)
-		_, err := client.DeleteEligibility(ctx, &awsteam.DeleteEligibilityInput{Id: ptr.String(rs.Primary.ID)})
+		client, err := acctest.NewAWSTeamClient(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = client.DeleteEligibility(ctx, &awsteam.DeleteEligibilityInput{Id: ptr.String(rs.Primary.ID)})
 
 		if err != nil {
 			return err
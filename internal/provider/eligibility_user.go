@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -214,6 +215,13 @@ func (r *EligibilityUserResource) Read(ctx context.Context, req resource.ReadReq
 
 	out, err := r.client.GetEligibility(ctx, in)
 
+	var notFound *awsteam.NotFoundError
+	if errors.As(err, &notFound) {
+		tflog.Warn(ctx, "Eligibility not found, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read eligibility user policy, got error: %s", err))
 		return
@@ -347,6 +355,11 @@ func (r *EligibilityUserResource) Delete(ctx context.Context, req resource.Delet
 
 	_, err := r.client.DeleteEligibility(ctx, in)
 
+	var notFound *awsteam.NotFoundError
+	if errors.As(err, &notFound) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete eligibility user, got error: %s", err))
 		return
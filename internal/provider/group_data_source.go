@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &GroupDataSource{}
+
+func NewGroupDataSource() datasource.DataSource {
+	return &GroupDataSource{}
+}
+
+type GroupDataSource struct {
+	client *awsteam.Client
+}
+
+type GroupModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+}
+
+func (d *GroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for a single IAM Identity Center group, looked up by id or display name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The IAM Identity Center group id. Exactly one of `id` or `display_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("display_name")),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the group. Exactly one of `id` or `display_name` must be set. The lookup fails unless exactly one group has this display name.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetIdCGroupsInput{}
+
+	out, err := d.client.GetIdCGroups(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read groups, got error: %s", err))
+		return
+	}
+
+	var matches []*awsteam.Group
+	var lookup string
+
+	if !data.Id.IsNull() {
+		lookup = fmt.Sprintf("id %q", data.Id.ValueString())
+	} else {
+		lookup = fmt.Sprintf("display name %q", data.DisplayName.ValueString())
+	}
+
+	for _, group := range out.Groups {
+		if !data.Id.IsNull() && ptr.ToString(group.Id) == data.Id.ValueString() {
+			matches = append(matches, group)
+		}
+
+		if !data.DisplayName.IsNull() && ptr.ToString(group.DisplayName) == data.DisplayName.ValueString() {
+			matches = append(matches, group)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Group Not Found", fmt.Sprintf("No group with %s was found.", lookup))
+		return
+	}
+
+	if len(matches) > 1 {
+		var ids []string
+
+		for _, group := range matches {
+			ids = append(ids, ptr.ToString(group.Id))
+		}
+
+		resp.Diagnostics.AddError("Multiple Groups Found", fmt.Sprintf("%d groups with %s were found: %v. Look the group up by id instead.", len(matches), lookup, ids))
+		return
+	}
+
+	data.flatten(matches[0])
+	tflog.Trace(ctx, "read group data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *GroupModel) flatten(out *awsteam.Group) {
+	d.Id = types.StringPointerValue(out.Id)
+	d.DisplayName = types.StringPointerValue(out.DisplayName)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_group.test"
+
+	// This environment variable should be set to the id of an IAM Identity Center group.
+	groupIdVar := "AWSTEAM_TESTS_USER_GROUP_ID"
+	groupId := os.Getenv(groupIdVar)
+	if groupId == "" {
+		t.Skipf("Skipping Group Tests, Environment variable %s is not set.", groupIdVar)
+	}
+
+	// This environment variable should be set to the display name of the group id provided.
+	groupNameVar := "AWSTEAM_TESTS_USER_GROUP_NAME"
+	groupName := os.Getenv(groupNameVar)
+	if groupName == "" {
+		t.Skipf("Skipping Group Tests, Environment variable %s is not set.", groupNameVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupDataSourceConfig_displayName(groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", groupId),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", groupName),
+				),
+			},
+			{
+				Config: testAccGroupDataSourceConfig_id(groupId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", groupId),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", groupName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGroupDataSourceConfig_displayName("tf-acc-test-group-does-not-exist"),
+				ExpectError: regexp.MustCompile(`Group Not Found`),
+			},
+		},
+	})
+}
+
+func testAccGroupDataSourceConfig_id(id string) string {
+	return fmt.Sprintf(`
+data "awsteam_group" "test" {
+  id = %q
+}
+`, id)
+}
+
+func testAccGroupDataSourceConfig_displayName(displayName string) string {
+	return fmt.Sprintf(`
+data "awsteam_group" "test" {
+  display_name = %q
+}
+`, displayName)
+}
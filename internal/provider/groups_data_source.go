@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	groupsAttrTypes = map[string]attr.Type{
+		"id":           types.StringType,
+		"display_name": types.StringType,
+	}
+)
+var _ datasource.DataSource = &GroupsDataSource{}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+type GroupsDataSource struct {
+	client *awsteam.Client
+}
+
+type GroupsModel struct {
+	Id            types.String `tfsdk:"id"`
+	NameRegex     types.String `tfsdk:"name_regex"`
+	Groups        types.Set    `tfsdk:"groups"`
+	ByDisplayName types.Map    `tfsdk:"by_display_name"`
+}
+
+func (d *GroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the IAM Identity Center groups visible to AWS TEAM",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Groups Identifier. This is a static value of `groups`.",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression used to filter groups by display name.",
+				Optional:            true,
+				Validators: []validator.String{
+					ValidRegex(),
+				},
+			},
+			"groups": schema.SetNestedAttribute{
+				MarkdownDescription: "A set of IAM Identity Center groups.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The IAM Identity Center group id.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "The display name of the group.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"by_display_name": schema.MapAttribute{
+				MarkdownDescription: "A map of display name to group id for the groups returned. Display names shared by more than one group are left out of the map with a warning.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetIdCGroupsInput{}
+
+	out, err := d.client.GetIdCGroups(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read groups, got error: %s", err))
+		return
+	}
+
+	groups := out.Groups
+
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Unable to compile %q, got error: %s", data.NameRegex.ValueString(), err),
+			)
+			return
+		}
+
+		groups = filterGroups(groups, nameRegex)
+	}
+
+	resp.Diagnostics.Append(data.flatten(groups)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read groups data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *GroupsModel) flatten(groups []*awsteam.Group) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupsSet, diag := flattenGroups(groups)
+	diags.Append(diag...)
+
+	names := make([]*string, 0, len(groups))
+	ids := make([]*string, 0, len(groups))
+
+	for _, group := range groups {
+		names = append(names, group.DisplayName)
+		ids = append(ids, group.Id)
+	}
+
+	byDisplayNameMap, diag := flattenNameMap("by_display_name", names, ids)
+	diags.Append(diag...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("groups")
+	d.Groups = groupsSet
+	d.ByDisplayName = byDisplayNameMap
+
+	return diags
+}
+
+func filterGroups(groups []*awsteam.Group, nameRegex *regexp.Regexp) []*awsteam.Group {
+	var filtered []*awsteam.Group
+
+	for _, group := range groups {
+		if nameRegex.MatchString(ptr.ToString(group.DisplayName)) {
+			filtered = append(filtered, group)
+		}
+	}
+
+	return filtered
+}
+
+func flattenGroups(apiObject []*awsteam.Group) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: groupsAttrTypes}
+	elems := []attr.Value{}
+
+	for _, group := range apiObject {
+		obj := map[string]attr.Value{
+			"id":           types.StringPointerValue(group.Id),
+			"display_name": types.StringPointerValue(group.DisplayName),
+		}
+		objVal, d := types.ObjectValue(groupsAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+	setVal, d := types.SetValue(elemType, elems)
+	diags.Append(d...)
+
+	return setVal, diags
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_groups.test"
+
+	// This environment variable should be set to the id of an IAM Identity Center group.
+	groupIdVar := "AWSTEAM_TESTS_USER_GROUP_ID"
+	groupId := os.Getenv(groupIdVar)
+	if groupId == "" {
+		t.Skipf("Skipping Groups Tests, Environment variable %s is not set.", groupIdVar)
+	}
+
+	// This environment variable should be set to the display name of the group id provided.
+	groupNameVar := "AWSTEAM_TESTS_USER_GROUP_NAME"
+	groupName := os.Getenv(groupNameVar)
+	if groupName == "" {
+		t.Skipf("Skipping Groups Tests, Environment variable %s is not set.", groupNameVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "groups"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "groups.*",
+						map[string]string{
+							"id":           groupId,
+							"display_name": groupName,
+						}),
+				),
+			},
+			{
+				Config: testAccGroupsDataSourceConfig_nameRegex("^" + regexp.QuoteMeta(groupName) + "$"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "by_display_name.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupsDataSourceConfig() string {
+	return `data "awsteam_groups" "test" {}`
+}
+
+func testAccGroupsDataSourceConfig_nameRegex(nameRegex string) string {
+	return fmt.Sprintf(`
+data "awsteam_groups" "test" {
+  name_regex = %q
+}
+`, nameRegex)
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	ousAttrTypes = map[string]attr.Type{
+		"id":        types.StringType,
+		"arn":       types.StringType,
+		"name":      types.StringType,
+		"parent_id": types.StringType,
+	}
+)
+var _ datasource.DataSource = &OUsDataSource{}
+
+func NewOUsDataSource() datasource.DataSource {
+	return &OUsDataSource{}
+}
+
+type OUsDataSource struct {
+	client *awsteam.Client
+}
+
+type OUsModel struct {
+	Id   types.String `tfsdk:"id"`
+	OUs  types.List   `tfsdk:"ous"`
+	Tree types.String `tfsdk:"tree"`
+}
+
+func (d *OUsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ous"
+}
+
+func (d *OUsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the organizational units visible to AWS TEAM",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "OUs Identifier. This is a static value of `ous` as it contains all organizational units.",
+				Computed:            true,
+			},
+			"ous": schema.ListNestedAttribute{
+				MarkdownDescription: "A flat list of the organizational units, including the organization root, ordered depth first from the root.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The id of the organizational unit, or of the root.",
+							Computed:            true,
+						},
+						"arn": schema.StringAttribute{
+							MarkdownDescription: "The ARN of the organizational unit.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the organizational unit. Names are only unique among siblings.",
+							Computed:            true,
+						},
+						"parent_id": schema.StringAttribute{
+							MarkdownDescription: "The id of the parent organizational unit. This is null for the root.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"tree": schema.StringAttribute{
+				MarkdownDescription: "The organization hierarchy as a JSON encoded list of nested objects with `id`, `arn`, `name` and `children` keys. Use `jsondecode` to traverse it.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OUsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OUsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OUsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetOUsInput{}
+
+	out, err := d.client.GetOUs(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ous, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(out)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read ous data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OUsModel) flatten(out *awsteam.GetOUsOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ousList, diags := flattenOUs(out.OUs)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	tree, err := json.Marshal(out.OUs)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to encode ou tree, got error: %s", err))
+		return diags
+	}
+
+	d.Id = types.StringValue("ous")
+	d.OUs = ousList
+	d.Tree = types.StringValue(string(tree))
+
+	return diags
+}
+
+func flattenOUs(apiObject []awsteam.OU) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: ousAttrTypes}
+	elems := []attr.Value{}
+
+	awsteam.WalkOUs(apiObject, func(ou *awsteam.OU, ancestors []*awsteam.OU) bool {
+		parentId := types.StringNull()
+
+		if len(ancestors) > 0 {
+			parentId = types.StringPointerValue(ancestors[len(ancestors)-1].Id)
+		}
+
+		obj := map[string]attr.Value{
+			"id":        types.StringPointerValue(ou.Id),
+			"arn":       types.StringPointerValue(ou.Arn),
+			"name":      types.StringPointerValue(ou.Name),
+			"parent_id": parentId,
+		}
+		objVal, d := types.ObjectValue(ousAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+
+		return true
+	})
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
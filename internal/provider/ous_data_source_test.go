@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOUsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_ous.test"
+
+	// This environment variable should be set to the id of an OU expected to be returned.
+	expectedOUIdVar := "AWSTEAM_TESTS_EXPECTED_OU_ID"
+	expectedOUId := os.Getenv(expectedOUIdVar)
+	if expectedOUId == "" {
+		t.Skipf("Skipping OUs Tests, Environment variable %s is not set.", expectedOUIdVar)
+	}
+
+	// This environment variable should be set to the name of the OU id provided.
+	expectedOUNameVar := "AWSTEAM_TESTS_EXPECTED_OU_NAME"
+	expectedOUName := os.Getenv(expectedOUNameVar)
+	if expectedOUName == "" {
+		t.Skipf("Skipping OUs Tests, Environment variable %s is not set.", expectedOUNameVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOUsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "ous"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "ous.*",
+						map[string]string{
+							"id":   expectedOUId,
+							"name": expectedOUName,
+						}),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tree"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOUsDataSourceConfig() string {
+	return `data "awsteam_ous" "test" {}`
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	permissionSetsAttrTypes = map[string]attr.Type{
+		"arn":                      types.StringType,
+		"name":                     types.StringType,
+		"session_duration":         types.StringType,
+		"session_duration_seconds": types.Int64Type,
+	}
+)
+var _ datasource.DataSource = &PermissionSetsDataSource{}
+
+func NewPermissionSetsDataSource() datasource.DataSource {
+	return &PermissionSetsDataSource{}
+}
+
+type PermissionSetsDataSource struct {
+	client *awsteam.Client
+}
+
+type PermissionSetsModel struct {
+	Id             types.String `tfsdk:"id"`
+	NameRegex      types.String `tfsdk:"name_regex"`
+	PermissionSets types.List   `tfsdk:"permission_sets"`
+}
+
+func (d *PermissionSetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_sets"
+}
+
+func (d *PermissionSetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the IAM Identity Center permission sets available to AWS TEAM",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Permission Sets Identifier. This is a static value of `permission_sets`.",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression used to filter permission sets by name.",
+				Optional:            true,
+				Validators: []validator.String{
+					ValidRegex(),
+				},
+			},
+			"permission_sets": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of permission sets, in the order returned by AWS TEAM.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"arn": schema.StringAttribute{
+							MarkdownDescription: "The ARN of the permission set.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the permission set.",
+							Computed:            true,
+						},
+						"session_duration": schema.StringAttribute{
+							MarkdownDescription: "The session duration of the permission set in ISO 8601 format, such as `PT1H`.",
+							Computed:            true,
+						},
+						"session_duration_seconds": schema.Int64Attribute{
+							MarkdownDescription: "The session duration of the permission set in seconds.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionSetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PermissionSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionSetsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetPermissionsInput{}
+
+	out, err := d.client.GetPermissions(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission sets, got error: %s", err))
+		return
+	}
+
+	permissions := out.Permissions
+
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Unable to compile %q, got error: %s", data.NameRegex.ValueString(), err),
+			)
+			return
+		}
+
+		permissions = filterPermissionSets(permissions, nameRegex)
+	}
+
+	resp.Diagnostics.Append(data.flatten(permissions)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read permission sets data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *PermissionSetsModel) flatten(permissions []*awsteam.Permission) diag.Diagnostics {
+	permissionSetsList, diags := flattenPermissionSets(permissions)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("permission_sets")
+	d.PermissionSets = permissionSetsList
+
+	return diags
+}
+
+func filterPermissionSets(permissions []*awsteam.Permission, nameRegex *regexp.Regexp) []*awsteam.Permission {
+	var filtered []*awsteam.Permission
+
+	for _, permission := range permissions {
+		if nameRegex.MatchString(ptr.ToString(permission.Name)) {
+			filtered = append(filtered, permission)
+		}
+	}
+
+	return filtered
+}
+
+func flattenPermissionSets(apiObject []*awsteam.Permission) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: permissionSetsAttrTypes}
+	elems := []attr.Value{}
+
+	for _, permission := range apiObject {
+		duration, err := permission.SessionDuration()
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to parse session duration of permission set %s, got error: %s", ptr.ToString(permission.Name), err))
+			continue
+		}
+
+		obj := map[string]attr.Value{
+			"arn":                      types.StringPointerValue(permission.Arn),
+			"name":                     types.StringPointerValue(permission.Name),
+			"session_duration":         types.StringPointerValue(permission.Duration),
+			"session_duration_seconds": types.Int64Value(int64(duration.Seconds())),
+		}
+		objVal, d := types.ObjectValue(permissionSetsAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(elemType), diags
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
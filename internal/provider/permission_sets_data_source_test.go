@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPermissionSetsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_permission_sets.test"
+
+	// This environment variable should be set to the name of a permission set expected to be returned.
+	expectedPermissionSetNameVar := "AWSTEAM_TESTS_EXPECTED_PERMISSION_SET_NAME"
+	expectedPermissionSetName := os.Getenv(expectedPermissionSetNameVar)
+	if expectedPermissionSetName == "" {
+		t.Skipf("Skipping Permission Sets Tests, Environment variable %s is not set.", expectedPermissionSetNameVar)
+	}
+
+	// This environment variable should be set to the ARN of the permission set name provided.
+	expectedPermissionSetArnVar := "AWSTEAM_TESTS_EXPECTED_PERMISSION_SET_ARN"
+	expectedPermissionSetArn := os.Getenv(expectedPermissionSetArnVar)
+	if expectedPermissionSetArn == "" {
+		t.Skipf("Skipping Permission Sets Tests, Environment variable %s is not set.", expectedPermissionSetArnVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "permission_sets"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "permission_sets.*",
+						map[string]string{
+							"arn":  expectedPermissionSetArn,
+							"name": expectedPermissionSetName,
+						}),
+				),
+			},
+			{
+				Config: testAccPermissionSetsDataSourceConfig_nameRegex("^" + regexp.QuoteMeta(expectedPermissionSetName) + "$"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "permission_sets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "permission_sets.0.arn", expectedPermissionSetArn),
+					resource.TestCheckResourceAttr(dataSourceName, "permission_sets.0.name", expectedPermissionSetName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "permission_sets.0.session_duration_seconds"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPermissionSetsDataSource_invalidNameRegex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPermissionSetsDataSourceConfig_nameRegex("("),
+				ExpectError: regexp.MustCompile(`Invalid Regular Expression`),
+			},
+		},
+	})
+}
+
+func testAccPermissionSetsDataSourceConfig() string {
+	return `data "awsteam_permission_sets" "test" {}`
+}
+
+func testAccPermissionSetsDataSourceConfig_nameRegex(nameRegex string) string {
+	return fmt.Sprintf(`
+data "awsteam_permission_sets" "test" {
+  name_regex = %q
+}
+`, nameRegex)
+}
@@ -5,15 +5,23 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/brittandeyoung/terraform-provider-awsteam/internal/envvar"
 	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -25,7 +33,6 @@ const (
 type AWSTEAMClient struct {
 	Client        *awsteam.Client
 	Config        *awsteam.Config
-	Token         *awsteam.Token
 	GraphEndpoint string
 }
 
@@ -36,10 +43,32 @@ type AWSTEAMProvider struct {
 }
 
 type AWSTEAMProviderModel struct {
-	ClientId      types.String `tfsdk:"client_id"`
-	ClientSecret  types.String `tfsdk:"client_secret"`
-	GraphEndpoint types.String `tfsdk:"graph_endpoint"`
-	TokenEndpoint types.String `tfsdk:"token_endpoint"`
+	APIKey          types.String `tfsdk:"api_key"`
+	AccessKey       types.String `tfsdk:"access_key"`
+	AccessToken     types.String `tfsdk:"access_token"`
+	AmplifyConfig   types.String `tfsdk:"amplify_config_file"`
+	AssumeRole      types.Object `tfsdk:"assume_role"`
+	AuthMode        types.String `tfsdk:"auth_mode"`
+	ClientId        types.String `tfsdk:"client_id"`
+	ClientSecret    types.String `tfsdk:"client_secret"`
+	GraphEndpoint   types.String `tfsdk:"graph_endpoint"`
+	TokenEndpoint   types.String `tfsdk:"token_endpoint"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	Profile         types.String `tfsdk:"profile"`
+	Region          types.String `tfsdk:"region"`
+	RetryMaxBackoff types.Int64  `tfsdk:"retry_max_backoff"`
+	Scopes          types.List   `tfsdk:"scopes"`
+	SecretKey       types.String `tfsdk:"secret_key"`
+	SessionToken    types.String `tfsdk:"session_token"`
+	TokenAuthMethod types.String `tfsdk:"token_auth_method"`
+	TokenCommand    types.List   `tfsdk:"token_command"`
+}
+
+type AssumeRoleModel struct {
+	RoleArn     types.String `tfsdk:"role_arn"`
+	SessionName types.String `tfsdk:"session_name"`
+	ExternalId  types.String `tfsdk:"external_id"`
+	Duration    types.Int64  `tfsdk:"duration"`
 }
 
 func (p *AWSTEAMProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -53,22 +82,148 @@ func (p *AWSTEAMProvider) Schema(ctx context.Context, req provider.SchemaRequest
 			"To use this provider, follow the [instructions to enable machine authentication](https://aws-samples.github.io/iam-identity-center-team/docs/deployment/configuration/cognito_machine_auth.html) on your TEAM deployment and retrieve the details of your deployment to be used for configuring this provider.",
 
 		Attributes: map[string]schema.Attribute{
+			"auth_mode": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How the provider authenticates to the graph endpoint. One of `%s`, `%s`, `%s`, `%s` or `%s` to sign requests with AWS IAM credentials. This can also be defined by setting the `%s` environment variable. Defaults to the mode selected by the attributes that are configured. Once a mode is selected, the environment variables of the other modes are ignored.", awsteam.AuthModeClientCredentials, awsteam.AuthModeAccessToken, awsteam.AuthModeTokenCommand, awsteam.AuthModeAPIKey, awsteam.AuthModeIAM, envvar.AWSTEAMAuthMode),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(awsteam.AuthModeClientCredentials, awsteam.AuthModeAccessToken, awsteam.AuthModeTokenCommand, awsteam.AuthModeAPIKey, awsteam.AuthModeIAM),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("An AppSync API key sent in the `x-api-key` header instead of authenticating with a token. No token is fetched from the token endpoint. This can also be defined by setting the `%s` environment variable. Conflicts with `access_token`, `client_id`, `client_secret` and `token_command`.", envvar.AWSTEAMAPIKey),
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token"), path.MatchRoot("client_id"), path.MatchRoot("client_secret"), path.MatchRoot("token_command")),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The AWS region of the graph endpoint, used when `auth_mode` is `iam`. Defaults to the region in `amplify_config_file`, then the region in the graph endpoint when it is the default AppSync domain, or the region of the AWS configuration for custom domains.",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The AWS shared config profile to load credentials from when `auth_mode` is `iam`. The standard AWS environment variables and shared config files are used when no credentials are configured.",
+				Optional:            true,
+			},
+			"access_key": schema.StringAttribute{
+				MarkdownDescription: "The AWS access key used to sign requests when `auth_mode` is `iam`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("secret_key")),
+				},
+			},
+			"secret_key": schema.StringAttribute{
+				MarkdownDescription: "The AWS secret key used to sign requests when `auth_mode` is `iam`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("access_key")),
+				},
+			},
+			"session_token": schema.StringAttribute{
+				MarkdownDescription: "The AWS session token used with `access_key` and `secret_key` when `auth_mode` is `iam`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("access_key")),
+				},
+			},
+			"assume_role": schema.SingleNestedAttribute{
+				MarkdownDescription: "A role to assume with the AWS credentials before signing requests when `auth_mode` is `iam`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"role_arn": schema.StringAttribute{
+						MarkdownDescription: "The ARN of the role to assume.",
+						Required:            true,
+					},
+					"session_name": schema.StringAttribute{
+						MarkdownDescription: "The name of the role session.",
+						Optional:            true,
+					},
+					"external_id": schema.StringAttribute{
+						MarkdownDescription: "The external id required by the role's trust policy.",
+						Optional:            true,
+					},
+					"duration": schema.Int64Attribute{
+						MarkdownDescription: "The duration of the role session in seconds. Defaults to `900`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(900, 43200),
+						},
+					},
+				},
+			},
+			"amplify_config_file": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Path to the `aws-exports.js` or `amplify_outputs.json` file generated by Amplify for the AWS TEAM deployment. The AppSync URL, region and Cognito domain in the file are used for `graph_endpoint`, `region` and `token_endpoint` when they are not configured otherwise. This can also be defined by setting the `%s` environment variable.", envvar.AWSTEAMAmplifyConfigFile),
+				Optional:            true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("A bearer token used to authenticate to the graph endpoint instead of fetching one with the client credentials. The token is not refreshed, so it must remain valid for the duration of the run. This can also be defined by setting the `%s` environment variable. Conflicts with `client_id`, `client_secret`, `token_command` and `token_endpoint`.", envvar.AWSTEAMAccessToken),
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_id"), path.MatchRoot("client_secret"), path.MatchRoot("token_command"), path.MatchRoot("token_endpoint")),
+				},
+			},
 			"client_id": schema.StringAttribute{
-				MarkdownDescription: "The client id for authenticating to the oauth2 token endpoint. This can also be defined by setting the `AWSTEAM_CLIENT_ID` environment variable. Attribute is required when not configured via environment variable.",
+				MarkdownDescription: "The client id for authenticating to the oauth2 token endpoint. This can also be defined by setting the `AWSTEAM_CLIENT_ID` environment variable. Attribute is required when authenticating with client credentials and it is not configured via environment variable.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token"), path.MatchRoot("token_command")),
+				},
 			},
 			"client_secret": schema.StringAttribute{
-				MarkdownDescription: "The client secret for authenticating to the oauth2 token endpoint. This can also be defined by setting the `AWSTEAM_CLIENT_SECRET` environment variable. Attribute is required when not configured via environment variable.",
+				MarkdownDescription: "The client secret for authenticating to the oauth2 token endpoint. This can also be defined by setting the `AWSTEAM_CLIENT_SECRET` environment variable. Attribute is required when authenticating with client credentials and it is not configured via environment variable.",
 				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token"), path.MatchRoot("token_command")),
+				},
 			},
 			"graph_endpoint": schema.StringAttribute{
 				MarkdownDescription: "The graph endpoint for the AWS TEAM deployment. This can also be defined by setting the `AWSTEAM_GRAPH_ENDPOINT` environment variable. Attribute is required when not configured via environment variable.",
 				Optional:            true,
 			},
 			"token_endpoint": schema.StringAttribute{
-				MarkdownDescription: "The token endpoint for the oath2 authenticator for AWS TEAMS. This can also be defined by setting the `AWSTEAM_TOKEN_ENDPOINT` environment variable. Attribute is required when not configured via environment variable.",
+				MarkdownDescription: "The token endpoint for the oath2 authenticator for AWS TEAMS. This can also be defined by setting the `AWSTEAM_TOKEN_ENDPOINT` environment variable. Attribute is required when authenticating with client credentials and it is not configured via environment variable.",
+				Optional:            true,
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: fmt.Sprintf("The scopes requested with the oauth2 token. This can also be defined by setting the `%s` environment variable to a space or comma separated list. Defaults to `[%q]`.", envvar.AWSTEAMScopes, awsteam.DefaultScopes[0]),
 				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"token_command": schema.ListAttribute{
+				MarkdownDescription: fmt.Sprintf("A command and its arguments that print a token to stdout as JSON, used instead of fetching one with the client credentials. The output must contain an `access_token` and may contain `expires_in` seconds or an RFC 3339 `expiration`, after which the command is run again. This can also be defined by setting the `%s` environment variable to a space separated command. Conflicts with `access_token`, `client_id` and `client_secret`.", envvar.AWSTEAMTokenCommand),
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"token_auth_method": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How the client id and secret are sent to the oauth2 token endpoint. Either `%s` to send them in the request body (`client_secret_post`) or `%s` to send them in a basic Authorization header (`client_secret_basic`). This can also be defined by setting the `%s` environment variable. Defaults to `%s`.", awsteam.TokenAuthMethodPost, awsteam.TokenAuthMethodBasic, envvar.AWSTEAMTokenAuthMethod, awsteam.TokenAuthMethodPost),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(awsteam.TokenAuthMethodPost, awsteam.TokenAuthMethodBasic),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of times a request that failed due to throttling, a server error or a transient DynamoDB error is retried. Requests that create, update or delete items are only retried when they were throttled or the connection was refused, as the change may already have been made. Set to `0` to disable retries. Defaults to `%d`.", awsteam.DefaultMaxAttempts-1),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_backoff": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of seconds to wait between retries. Waits grow exponentially with jitter up to this value. Defaults to `%d`.", int64(awsteam.DefaultMaxBackoff/time.Second)),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 		},
 	}
@@ -83,25 +238,144 @@ func (p *AWSTEAMProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	clientId := fieldOrEnvVar(data.ClientId, "client_id", envvar.AWSTEAMClientId, resp)
-	clientSecret := fieldOrEnvVar(data.ClientSecret, "client_secret", envvar.AWSTEAMClientSecret, resp)
-	graphEndpoint := fieldOrEnvVar(data.GraphEndpoint, "graph_endpoint", envvar.AWSTEAMGraphEndpoint, resp)
-	TokenEndpoint := fieldOrEnvVar(data.TokenEndpoint, "token_endpoint", envvar.AWSTEAMTokenEndpoint, resp)
+	authMode := optionalFieldOrEnvVar(data.AuthMode, envvar.AWSTEAMAuthMode)
+
+	// A mode selected with auth_mode or by configuring its attributes takes precedence over the
+	// environment, so the environment variables of other modes, such as client credentials exported
+	// in CI, are ignored instead of conflicting with it.
+	selectedMode := authMode
+
+	if selectedMode == "" {
+		selectedMode = configuredAuthMode(data)
+	}
+
+	readsEnv := func(mode string) bool {
+		return selectedMode == "" || selectedMode == mode
+	}
+
+	apiKey := authFieldOrEnvVar(data.APIKey, envvar.AWSTEAMAPIKey, readsEnv(awsteam.AuthModeAPIKey))
+	accessToken := authFieldOrEnvVar(data.AccessToken, envvar.AWSTEAMAccessToken, readsEnv(awsteam.AuthModeAccessToken))
+
+	var tokenCommand []string
+
+	if !data.TokenCommand.IsNull() {
+		resp.Diagnostics.Append(data.TokenCommand.ElementsAs(ctx, &tokenCommand, false)...)
+	} else if readsEnv(awsteam.AuthModeTokenCommand) {
+		tokenCommand = strings.Fields(os.Getenv(envvar.AWSTEAMTokenCommand))
+	}
+
+	amplifyConfig := &awsteam.AmplifyConfig{}
+
+	if amplifyConfigFile := optionalFieldOrEnvVar(data.AmplifyConfig, envvar.AWSTEAMAmplifyConfigFile); amplifyConfigFile != "" {
+		var err error
+		amplifyConfig, err = awsteam.LoadAmplifyConfig(amplifyConfigFile)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Amplify Config File", fmt.Sprintf("Unable to read the AWS TEAM deployment settings from %s, got error: %s", amplifyConfigFile, err))
+			return
+		}
+	}
+
+	graphEndpoint := fieldOrEnvVar(data.GraphEndpoint, "graph_endpoint", envvar.AWSTEAMGraphEndpoint, amplifyConfig.GraphEndpoint, resp)
+
+	var clientId, clientSecret, TokenEndpoint string
+
+	// The client credentials are only required when the token is not provided some other way.
+	if authMode == awsteam.AuthModeClientCredentials || (authMode == "" && apiKey == "" && accessToken == "" && len(tokenCommand) == 0) {
+		clientId = fieldOrEnvVar(data.ClientId, "client_id", envvar.AWSTEAMClientId, "", resp)
+		clientSecret = fieldOrEnvVar(data.ClientSecret, "client_secret", envvar.AWSTEAMClientSecret, "", resp)
+		TokenEndpoint = fieldOrEnvVar(data.TokenEndpoint, "token_endpoint", envvar.AWSTEAMTokenEndpoint, amplifyConfig.TokenEndpoint, resp)
+	} else {
+		clientId = authFieldOrEnvVar(data.ClientId, envvar.AWSTEAMClientId, readsEnv(awsteam.AuthModeClientCredentials))
+		clientSecret = authFieldOrEnvVar(data.ClientSecret, envvar.AWSTEAMClientSecret, readsEnv(awsteam.AuthModeClientCredentials))
+		TokenEndpoint = authFieldOrEnvVar(data.TokenEndpoint, envvar.AWSTEAMTokenEndpoint, readsEnv(awsteam.AuthModeClientCredentials))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+
+	if data.Scopes.IsNull() {
+		scopes = strings.FieldsFunc(os.Getenv(envvar.AWSTEAMScopes), func(r rune) bool {
+			return r == ',' || r == ' '
+		})
+	} else {
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+	}
+
+	tokenAuthMethod := optionalFieldOrEnvVar(data.TokenAuthMethod, envvar.AWSTEAMTokenAuthMethod)
+
+	if tokenAuthMethod != "" && tokenAuthMethod != awsteam.TokenAuthMethodPost && tokenAuthMethod != awsteam.TokenAuthMethodBasic {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("The %s environment variable must be either %q or %q, got: %q.", envvar.AWSTEAMTokenAuthMethod, awsteam.TokenAuthMethodPost, awsteam.TokenAuthMethodBasic, tokenAuthMethod))
+	}
+
+	iam := &awsteam.IAMConfig{
+		AccessKey:    data.AccessKey.ValueString(),
+		Profile:      data.Profile.ValueString(),
+		Region:       data.Region.ValueString(),
+		SecretKey:    data.SecretKey.ValueString(),
+		SessionToken: data.SessionToken.ValueString(),
+	}
+
+	if iam.Region == "" {
+		iam.Region = amplifyConfig.Region
+	}
+
+	if !data.AssumeRole.IsNull() {
+		var assumeRole AssumeRoleModel
+		resp.Diagnostics.Append(data.AssumeRole.As(ctx, &assumeRole, basetypes.ObjectAsOptions{})...)
+
+		iam.AssumeRole = &awsteam.AssumeRoleConfig{
+			Duration:    time.Duration(assumeRole.Duration.ValueInt64()) * time.Second,
+			ExternalId:  assumeRole.ExternalId.ValueString(),
+			RoleArn:     assumeRole.RoleArn.ValueString(),
+			SessionName: assumeRole.SessionName.ValueString(),
+		}
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	retryer := awsteam.NewRetryer()
+
+	if !data.MaxRetries.IsNull() {
+		retryer.MaxAttempts = int(data.MaxRetries.ValueInt64()) + 1
+	}
+
+	if !data.RetryMaxBackoff.IsNull() {
+		retryer.MaxBackoff = time.Duration(data.RetryMaxBackoff.ValueInt64()) * time.Second
+	}
+
 	config := &awsteam.Config{
-		ClientId:      clientId,
-		ClientSecret:  clientSecret,
-		GraphEndpoint: graphEndpoint,
-		TokenEndpoint: TokenEndpoint,
+		APIKey:          apiKey,
+		AccessToken:     accessToken,
+		AuthMode:        authMode,
+		IAM:             iam,
+		TokenCommand:    tokenCommand,
+		ClientId:        clientId,
+		ClientSecret:    clientSecret,
+		GraphEndpoint:   graphEndpoint,
+		TokenEndpoint:   TokenEndpoint,
+		Retryer:         retryer,
+		Scopes:          scopes,
+		TokenAuthMethod: tokenAuthMethod,
 	}
 
-	config.Build(ctx)
+	if err := config.Build(ctx); err != nil {
+		summary, detail := configErrorDiagnostic(err)
+		resp.Diagnostics.AddError(summary, detail)
+		return
+	}
 
-	meta := config.NewClient(ctx)
+	meta, err := config.NewClient(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create AWS TEAM client, got error: %s", err))
+		return
+	}
 
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
@@ -119,8 +393,23 @@ func (p *AWSTEAMProvider) Resources(ctx context.Context) []func() resource.Resou
 
 func (p *AWSTEAMProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAccountDataSource,
 		NewAccountsDataSource,
+		NewApproversDataSource,
+		NewApproversListDataSource,
+		NewAuditSessionsDataSource,
+		NewEffectiveApproversDataSource,
+		NewEffectiveEligibilityDataSource,
+		NewEligibilitiesDataSource,
+		NewEligibilityDataSource,
+		NewGroupDataSource,
+		NewGroupsDataSource,
+		NewOUsDataSource,
+		NewPermissionSetsDataSource,
+		NewRequestsDataSource,
 		NewSettingsDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
 	}
 }
 
@@ -132,10 +421,13 @@ func New(version string) func() provider.Provider {
 	}
 }
 
-func fieldOrEnvVar(field basetypes.StringValue, fieldName string, envvarName string, resp *provider.ConfigureResponse) string {
+func fieldOrEnvVar(field basetypes.StringValue, fieldName string, envvarName string, fallback string, resp *provider.ConfigureResponse) string {
 	var value string
 	if field.IsNull() {
 		value = os.Getenv(envvarName)
+		if value == "" {
+			value = fallback
+		}
 		if value == "" {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Providing a value for %s is required. This can also be handled by setting the %s environment variable.", fieldName, envvarName))
 		}
@@ -144,3 +436,84 @@ func fieldOrEnvVar(field basetypes.StringValue, fieldName string, envvarName str
 	}
 	return value
 }
+
+// optionalFieldOrEnvVar returns the value of the field, falling back to the environment variable
+// when the field is not set. Unlike fieldOrEnvVar, it is not an error for both to be empty.
+func optionalFieldOrEnvVar(field basetypes.StringValue, envvarName string) string {
+	if field.IsNull() {
+		return os.Getenv(envvarName)
+	}
+
+	return field.ValueString()
+}
+
+// authFieldOrEnvVar returns the value of the field, falling back to the environment variable only
+// when readEnv is set.
+func authFieldOrEnvVar(field basetypes.StringValue, envvarName string, readEnv bool) string {
+	if !field.IsNull() || !readEnv {
+		return field.ValueString()
+	}
+
+	return os.Getenv(envvarName)
+}
+
+// configuredAuthMode returns the auth mode whose attributes are set in the provider configuration,
+// or an empty string when none or more than one are.
+func configuredAuthMode(data AWSTEAMProviderModel) string {
+	var modes []string
+
+	if !data.APIKey.IsNull() {
+		modes = append(modes, awsteam.AuthModeAPIKey)
+	}
+
+	if !data.AccessToken.IsNull() {
+		modes = append(modes, awsteam.AuthModeAccessToken)
+	}
+
+	if !data.TokenCommand.IsNull() {
+		modes = append(modes, awsteam.AuthModeTokenCommand)
+	}
+
+	if !data.ClientId.IsNull() || !data.ClientSecret.IsNull() {
+		modes = append(modes, awsteam.AuthModeClientCredentials)
+	}
+
+	if len(modes) != 1 {
+		return ""
+	}
+
+	return modes[0]
+}
+
+// configErrorDiagnostic returns a diagnostic summary and detail describing an error returned while building the client config.
+func configErrorDiagnostic(err error) (string, string) {
+	var authConfig *awsteam.AuthConfigError
+	var awsCredentials *awsteam.AWSCredentialsError
+	var tokenCommand *awsteam.TokenCommandError
+	var invalidEndpoint *awsteam.InvalidEndpointError
+	var unreachable *awsteam.EndpointUnreachableError
+	var invalidClient *awsteam.InvalidClientError
+	var unexpectedStatus *awsteam.UnexpectedStatusError
+	var malformedToken *awsteam.MalformedTokenError
+
+	switch {
+	case errors.As(err, &authConfig):
+		return "Invalid Authentication Configuration", fmt.Sprintf("Configure only one of api_key, access_token, token_command or client_id and client_secret, including through their environment variables, and only the settings of the selected auth_mode.\n\nError: %s", authConfig)
+	case errors.As(err, &awsCredentials):
+		return "Unable to Load AWS Credentials", fmt.Sprintf("The provider could not load the AWS credentials used to sign requests when auth_mode is iam. Verify the configured access_key, profile or assume_role, or the AWS environment variables and shared config files.\n\nError: %s", awsCredentials)
+	case errors.As(err, &tokenCommand):
+		return "Token Command Failed", fmt.Sprintf("The provider could not get a token by running token_command. Verify the command can be run from this machine.\n\nError: %s", tokenCommand)
+	case errors.As(err, &invalidEndpoint):
+		return "Invalid Token Endpoint", fmt.Sprintf("The provider could not build a request for the token endpoint %q. Verify token_endpoint is a valid URL.\n\nError: %s", invalidEndpoint.Endpoint, invalidEndpoint.Err)
+	case errors.As(err, &unreachable):
+		return "Unable to Reach Token Endpoint", fmt.Sprintf("The provider could not connect to the token endpoint %q. Verify token_endpoint is correct and reachable from this machine.\n\nError: %s", unreachable.Endpoint, unreachable.Err)
+	case errors.As(err, &invalidClient):
+		return "Invalid Client Credentials", fmt.Sprintf("The token endpoint rejected the configured client_id and client_secret. Verify they match the machine authentication app client of the AWS TEAM deployment.\n\nError: %s", invalidClient)
+	case errors.As(err, &unexpectedStatus):
+		return "Unexpected Token Endpoint Response", fmt.Sprintf("The token endpoint responded with status %d. Verify token_endpoint points to the oauth2 token endpoint of the AWS TEAM deployment.\n\nError: %s", unexpectedStatus.StatusCode, unexpectedStatus)
+	case errors.As(err, &malformedToken):
+		return "Invalid Token Response", fmt.Sprintf("The token endpoint or token_command did not return a usable access token. Verify token_endpoint points to the oauth2 token endpoint of the AWS TEAM deployment, or that token_command prints a JSON object containing an access_token.\n\nError: %s", malformedToken)
+	default:
+		return "Client Error", fmt.Sprintf("Unable to authenticate to AWS TEAM, got error: %s", err)
+	}
+}
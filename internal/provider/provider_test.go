@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/envvar"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
@@ -14,3 +19,84 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 func testAccPreCheck(t *testing.T) {
 	// We do not currently have any PreChecks
 }
+
+// testProviderConfig returns a provider configuration with the given string attributes set and all
+// others null.
+func testProviderConfig(t *testing.T, p provider.Provider, values map[string]string) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := map[string]tftypes.Value{}
+
+	for name, attrType := range objectType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	for name, value := range values {
+		attrs[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attrs),
+	}
+}
+
+func TestProviderConfigure_ignoresEnvironmentOfOtherModes(t *testing.T) {
+	t.Setenv(envvar.AWSTEAMClientId, "client")
+	t.Setenv(envvar.AWSTEAMClientSecret, "secret")
+	t.Setenv(envvar.AWSTEAMTokenEndpoint, "https://example.auth.us-east-1.amazoncognito.com/oauth2/token")
+
+	p := New("test")()
+	resp := &provider.ConfigureResponse{}
+
+	p.Configure(context.Background(), provider.ConfigureRequest{
+		Config: testProviderConfig(t, p, map[string]string{
+			"api_key":        "da2-key",
+			"graph_endpoint": "https://example.appsync-api.us-east-1.amazonaws.com/graphql",
+		}),
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+}
+
+func TestProviderValidateConfig_accessTokenConflicts(t *testing.T) {
+	ctx := context.Background()
+
+	for _, attr := range []string{"client_id", "client_secret", "token_endpoint"} {
+		t.Run(attr, func(t *testing.T) {
+			p := New("test")()
+			config := testProviderConfig(t, p, map[string]string{
+				"access_token": "token",
+				attr:           "value",
+			})
+
+			raw, err := tfprotov6.NewDynamicValue(config.Raw.Type(), config.Raw)
+
+			if err != nil {
+				t.Fatalf("encoding config: %s", err)
+			}
+
+			server := providerserver.NewProtocol6(p)()
+			resp, err := server.ValidateProviderConfig(ctx, &tfprotov6.ValidateProviderConfigRequest{Config: &raw})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, d := range resp.Diagnostics {
+				if d.Severity == tfprotov6.DiagnosticSeverityError {
+					return
+				}
+			}
+
+			t.Errorf("expected access_token and %s to conflict", attr)
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// The format TEAM stores request start and end times in.
+const requestTimeFormat = "2006-01-02T15:04:05.000Z"
+
+var (
+	requestStatuses = []string{
+		"pending",
+		"approved",
+		"rejected",
+		"cancelled",
+		"expired",
+		"scheduled",
+		"in progress",
+		"ended",
+		"revoked",
+		"error",
+	}
+
+	requestsAttrTypes = map[string]attr.Type{
+		"id":            types.StringType,
+		"account_id":    types.StringType,
+		"account_name":  types.StringType,
+		"role":          types.StringType,
+		"role_id":       types.StringType,
+		"requester":     types.StringType,
+		"username":      types.StringType,
+		"status":        types.StringType,
+		"justification": types.StringType,
+		"ticket_no":     types.StringType,
+		"duration":      types.Int64Type,
+		"start_time":    types.StringType,
+		"end_time":      types.StringType,
+		"approver":      types.StringType,
+		"approver_id":   types.StringType,
+		"comment":       types.StringType,
+	}
+)
+var _ datasource.DataSource = &RequestsDataSource{}
+
+func NewRequestsDataSource() datasource.DataSource {
+	return &RequestsDataSource{}
+}
+
+type RequestsDataSource struct {
+	client *awsteam.Client
+}
+
+type RequestsModel struct {
+	Id              types.String `tfsdk:"id"`
+	Status          types.String `tfsdk:"status"`
+	AccountId       types.String `tfsdk:"account_id"`
+	Requester       types.String `tfsdk:"requester"`
+	StartTimeAfter  types.String `tfsdk:"start_time_after"`
+	StartTimeBefore types.String `tfsdk:"start_time_before"`
+	Requests        types.List   `tfsdk:"requests"`
+}
+
+func (d *RequestsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_requests"
+}
+
+func (d *RequestsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the elevated access requests of an AWS TEAM deployment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Requests Identifier. This is a static value of `requests`.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return requests with this status, such as `pending`, `approved`, `in progress`, `ended` or `rejected`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(requestStatuses...),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Only return requests for this AWS account id.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^\d{12}$`),
+						"value must be a valid aws account id.",
+					),
+				},
+			},
+			"requester": schema.StringAttribute{
+				MarkdownDescription: "Only return requests made by the user with this email address.",
+				Optional:            true,
+			},
+			"start_time_after": schema.StringAttribute{
+				MarkdownDescription: "Only return requests starting at or after this RFC 3339 timestamp.",
+				Optional:            true,
+				Validators: []validator.String{
+					ValidRFC3339(),
+				},
+			},
+			"start_time_before": schema.StringAttribute{
+				MarkdownDescription: "Only return requests starting at or before this RFC 3339 timestamp.",
+				Optional:            true,
+				Validators: []validator.String{
+					ValidRFC3339(),
+				},
+			},
+			"requests": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of elevated access requests, sorted by start time.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The id of the request.",
+							Computed:            true,
+						},
+						"account_id": schema.StringAttribute{
+							MarkdownDescription: "The AWS account id access was requested for.",
+							Computed:            true,
+						},
+						"account_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the AWS account access was requested for.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Name of the requested permission set.",
+							Computed:            true,
+						},
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: "The ARN of the requested permission set.",
+							Computed:            true,
+						},
+						"requester": schema.StringAttribute{
+							MarkdownDescription: "Email address of the user that made the request.",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "User name of the user that made the request.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the request.",
+							Computed:            true,
+						},
+						"justification": schema.StringAttribute{
+							MarkdownDescription: "The justification given for the request.",
+							Computed:            true,
+						},
+						"ticket_no": schema.StringAttribute{
+							MarkdownDescription: "The Change Management system ticket system number.",
+							Computed:            true,
+						},
+						"duration": schema.Int64Attribute{
+							MarkdownDescription: "The requested elevated access duration in hours.",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "The date and time elevated access starts.",
+							Computed:            true,
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "The date and time elevated access ends.",
+							Computed:            true,
+						},
+						"approver": schema.StringAttribute{
+							MarkdownDescription: "Email address of the user that approved or rejected the request.",
+							Computed:            true,
+						},
+						"approver_id": schema.StringAttribute{
+							MarkdownDescription: "The id of the user that approved or rejected the request.",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "The comment given by the approver.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RequestsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RequestsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RequestsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startTimeAfter, diags := requestTime(path.Root("start_time_after"), data.StartTimeAfter)
+	resp.Diagnostics.Append(diags...)
+
+	startTimeBefore, diags := requestTime(path.Root("start_time_before"), data.StartTimeBefore)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.ListRequestsInput{
+		Status:          data.Status.ValueStringPointer(),
+		AccountId:       data.AccountId.ValueStringPointer(),
+		Email:           data.Requester.ValueStringPointer(),
+		StartTimeAfter:  startTimeAfter,
+		StartTimeBefore: startTimeBefore,
+	}
+
+	var requests []*awsteam.Request
+	paginator := awsteam.NewListRequestsPaginator(d.client, in)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list requests, got error: %s", err))
+			return
+		}
+
+		requests = append(requests, page.Requests...)
+	}
+
+	sort.SliceStable(requests, func(i, j int) bool {
+		if ptr.ToString(requests[i].StartTime) != ptr.ToString(requests[j].StartTime) {
+			return ptr.ToString(requests[i].StartTime) < ptr.ToString(requests[j].StartTime)
+		}
+
+		return ptr.ToString(requests[i].Id) < ptr.ToString(requests[j].Id)
+	})
+
+	resp.Diagnostics.Append(data.flatten(requests)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read requests data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RequestsModel) flatten(requests []*awsteam.Request) diag.Diagnostics {
+	requestsList, diags := flattenRequests(requests)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("requests")
+	d.Requests = requestsList
+
+	return diags
+}
+
+// requestTime converts an RFC 3339 timestamp into the UTC format TEAM stores request times in, so
+// they can be compared as strings. The attribute validator skips unknown values, so the timestamp is
+// parsed again here and an error is reported against the attribute at p.
+func requestTime(p path.Path, value types.String) (*string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid RFC 3339 Timestamp",
+			fmt.Sprintf("Unable to parse %q, got error: %s", value.ValueString(), err),
+		)
+		return nil, diags
+	}
+
+	return ptr.String(t.UTC().Format(requestTimeFormat)), diags
+}
+
+// requestDuration returns the requested duration in hours, or null when it is not a whole number.
+func requestDuration(duration *string) types.Int64 {
+	hours, err := strconv.ParseInt(ptr.ToString(duration), 10, 64)
+
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(hours)
+}
+
+func flattenRequests(apiObject []*awsteam.Request) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: requestsAttrTypes}
+	elems := []attr.Value{}
+
+	for _, request := range apiObject {
+		obj := map[string]attr.Value{
+			"id":            types.StringPointerValue(request.Id),
+			"account_id":    types.StringPointerValue(request.AccountId),
+			"account_name":  types.StringPointerValue(request.AccountName),
+			"role":          types.StringPointerValue(request.Role),
+			"role_id":       types.StringPointerValue(request.RoleId),
+			"requester":     types.StringPointerValue(request.Email),
+			"username":      types.StringPointerValue(request.Username),
+			"status":        types.StringPointerValue(request.Status),
+			"justification": types.StringPointerValue(request.Justification),
+			"ticket_no":     types.StringPointerValue(request.TicketNo),
+			"duration":      requestDuration(request.Duration),
+			"start_time":    types.StringPointerValue(request.StartTime),
+			"end_time":      types.StringPointerValue(request.EndTime),
+			"approver":      types.StringPointerValue(request.Approver),
+			"approver_id":   types.StringPointerValue(request.ApproverId),
+			"comment":       types.StringPointerValue(request.Comment),
+		}
+		objVal, d := types.ObjectValue(requestsAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(elemType), diags
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
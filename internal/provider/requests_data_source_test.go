@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRequestsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_requests.test"
+	accountId := gofakeit.DigitN(12)
+	requester := gofakeit.Email()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// A random account and requester will not have made any requests.
+				Config: testAccRequestsDataSourceConfig(accountId, requester),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "requests"),
+					resource.TestCheckResourceAttr(dataSourceName, "requests.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRequestsDataSourceConfig(accountId, requester string) string {
+	return fmt.Sprintf(`
+data "awsteam_requests" "test" {
+  status            = "ended"
+  account_id        = %q
+  requester         = %q
+  start_time_after  = "2024-01-01T00:00:00Z"
+  start_time_before = "2024-12-31T23:59:59Z"
+}
+`, accountId, requester)
+}
+
+func TestRequestTime(t *testing.T) {
+	testCases := map[string]struct {
+		value     types.String
+		expected  *string
+		expectErr bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"utc": {
+			value:    types.StringValue("2024-01-02T03:04:05Z"),
+			expected: ptr.String("2024-01-02T03:04:05.000Z"),
+		},
+		"offset": {
+			value:    types.StringValue("2024-01-02T03:04:05+02:00"),
+			expected: ptr.String("2024-01-02T01:04:05.000Z"),
+		},
+		"invalid": {
+			value:     types.StringValue("yesterday"),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := requestTime(path.Root("start_time"), tc.value)
+
+			if diags.HasError() != tc.expectErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.expectErr, diags)
+			}
+
+			if ptr.ToString(got) != ptr.ToString(tc.expected) || (got == nil) != (tc.expected == nil) {
+				t.Errorf("expected %v, got %v", ptr.ToString(tc.expected), ptr.ToString(got))
+			}
+		})
+	}
+}
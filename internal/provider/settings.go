@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -235,6 +236,13 @@ func (r *SettingsResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	out, err := r.client.GetSettings(ctx, in)
 
+	var notFound *awsteam.NotFoundError
+	if errors.As(err, &notFound) {
+		tflog.Warn(ctx, "Settings not found, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
 		return
@@ -340,6 +348,11 @@ func (r *SettingsResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	_, err := r.client.DeleteSettings(ctx, in)
 
+	var notFound *awsteam.NotFoundError
+	if errors.As(err, &notFound) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
 		return
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+type UserDataSource struct {
+	client *awsteam.Client
+}
+
+type UserModel struct {
+	Id       types.String `tfsdk:"id"`
+	UserName types.String `tfsdk:"user_name"`
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for a single IAM Identity Center user, looked up by id or user name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The IAM Identity Center user id. Exactly one of `id` or `user_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_name")),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "The user name of the user. Exactly one of `id` or `user_name` must be set. The lookup fails unless exactly one user has this user name.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetUsersInput{}
+
+	out, err := d.client.GetUsers(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read users, got error: %s", err))
+		return
+	}
+
+	var matches []*awsteam.User
+	var lookup string
+
+	if !data.Id.IsNull() {
+		lookup = fmt.Sprintf("id %q", data.Id.ValueString())
+	} else {
+		lookup = fmt.Sprintf("user name %q", data.UserName.ValueString())
+	}
+
+	for _, user := range out.Users {
+		if !data.Id.IsNull() && ptr.ToString(user.Id) == data.Id.ValueString() {
+			matches = append(matches, user)
+		}
+
+		if !data.UserName.IsNull() && ptr.ToString(user.UserName) == data.UserName.ValueString() {
+			matches = append(matches, user)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("User Not Found", fmt.Sprintf("No user with %s was found.", lookup))
+		return
+	}
+
+	if len(matches) > 1 {
+		var ids []string
+
+		for _, user := range matches {
+			ids = append(ids, ptr.ToString(user.Id))
+		}
+
+		resp.Diagnostics.AddError("Multiple Users Found", fmt.Sprintf("%d users with %s were found: %v. Look the user up by id instead.", len(matches), lookup, ids))
+		return
+	}
+
+	data.flatten(matches[0])
+	tflog.Trace(ctx, "read user data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserModel) flatten(out *awsteam.User) {
+	d.Id = types.StringPointerValue(out.Id)
+	d.UserName = types.StringPointerValue(out.UserName)
+}
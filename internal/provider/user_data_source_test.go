@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_user.test"
+
+	// This environment variable should be set to the id of an IAM Identity Center user.
+	userIdVar := "AWSTEAM_TESTS_USER_ID"
+	userId := os.Getenv(userIdVar)
+	if userId == "" {
+		t.Skipf("Skipping User Tests, Environment variable %s is not set.", userIdVar)
+	}
+
+	// This environment variable should be set to the user name of the user id provided.
+	userNameVar := "AWSTEAM_TESTS_USER_NAME"
+	userName := os.Getenv(userNameVar)
+	if userName == "" {
+		t.Skipf("Skipping User Tests, Environment variable %s is not set.", userNameVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSourceConfig_userName(userName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", userId),
+					resource.TestCheckResourceAttr(dataSourceName, "user_name", userName),
+				),
+			},
+			{
+				Config: testAccUserDataSourceConfig_id(userId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", userId),
+					resource.TestCheckResourceAttr(dataSourceName, "user_name", userName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserDataSourceConfig_userName("tf-acc-test-user-does-not-exist"),
+				ExpectError: regexp.MustCompile(`User Not Found`),
+			},
+		},
+	})
+}
+
+func testAccUserDataSourceConfig_id(id string) string {
+	return fmt.Sprintf(`
+data "awsteam_user" "test" {
+  id = %q
+}
+`, id)
+}
+
+func testAccUserDataSourceConfig_userName(userName string) string {
+	return fmt.Sprintf(`
+data "awsteam_user" "test" {
+  user_name = %q
+}
+`, userName)
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/brittandeyoung/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	usersAttrTypes = map[string]attr.Type{
+		"id":        types.StringType,
+		"user_name": types.StringType,
+	}
+)
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+type UsersDataSource struct {
+	client *awsteam.Client
+}
+
+type UsersModel struct {
+	Id         types.String `tfsdk:"id"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Users      types.Set    `tfsdk:"users"`
+	ByUserName types.Map    `tfsdk:"by_user_name"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the IAM Identity Center users visible to AWS TEAM",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Users Identifier. This is a static value of `users`.",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression used to filter users by user name.",
+				Optional:            true,
+				Validators: []validator.String{
+					ValidRegex(),
+				},
+			},
+			"users": schema.SetNestedAttribute{
+				MarkdownDescription: "A set of IAM Identity Center users.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The IAM Identity Center user id.",
+							Computed:            true,
+						},
+						"user_name": schema.StringAttribute{
+							MarkdownDescription: "The user name of the user.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"by_user_name": schema.MapAttribute{
+				MarkdownDescription: "A map of user name to user id for the users returned. User names shared by more than one user are left out of the map with a warning.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*awsteam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetUsersInput{}
+
+	out, err := d.client.GetUsers(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read users, got error: %s", err))
+		return
+	}
+
+	users := out.Users
+
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Unable to compile %q, got error: %s", data.NameRegex.ValueString(), err),
+			)
+			return
+		}
+
+		users = filterUsers(users, nameRegex)
+	}
+
+	resp.Diagnostics.Append(data.flatten(users)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read users data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UsersModel) flatten(users []*awsteam.User) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	usersSet, diag := flattenUsers(users)
+	diags.Append(diag...)
+
+	names := make([]*string, 0, len(users))
+	ids := make([]*string, 0, len(users))
+
+	for _, user := range users {
+		names = append(names, user.UserName)
+		ids = append(ids, user.Id)
+	}
+
+	byUserNameMap, diag := flattenNameMap("by_user_name", names, ids)
+	diags.Append(diag...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("users")
+	d.Users = usersSet
+	d.ByUserName = byUserNameMap
+
+	return diags
+}
+
+func filterUsers(users []*awsteam.User, nameRegex *regexp.Regexp) []*awsteam.User {
+	var filtered []*awsteam.User
+
+	for _, user := range users {
+		if nameRegex.MatchString(ptr.ToString(user.UserName)) {
+			filtered = append(filtered, user)
+		}
+	}
+
+	return filtered
+}
+
+func flattenUsers(apiObject []*awsteam.User) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: usersAttrTypes}
+	elems := []attr.Value{}
+
+	for _, user := range apiObject {
+		obj := map[string]attr.Value{
+			"id":        types.StringPointerValue(user.Id),
+			"user_name": types.StringPointerValue(user.UserName),
+		}
+		objVal, d := types.ObjectValue(usersAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+	setVal, d := types.SetValue(elemType, elems)
+	diags.Append(d...)
+
+	return setVal, diags
+}
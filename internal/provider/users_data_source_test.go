@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_users.test"
+
+	// This environment variable should be set to the id of an IAM Identity Center user.
+	userIdVar := "AWSTEAM_TESTS_USER_ID"
+	userId := os.Getenv(userIdVar)
+	if userId == "" {
+		t.Skipf("Skipping Users Tests, Environment variable %s is not set.", userIdVar)
+	}
+
+	// This environment variable should be set to the user name of the user id provided.
+	userNameVar := "AWSTEAM_TESTS_USER_NAME"
+	userName := os.Getenv(userNameVar)
+	if userName == "" {
+		t.Skipf("Skipping Users Tests, Environment variable %s is not set.", userNameVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "users"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "users.*",
+						map[string]string{
+							"id":        userId,
+							"user_name": userName,
+						}),
+				),
+			},
+			{
+				Config: testAccUsersDataSourceConfig_nameRegex("^" + regexp.QuoteMeta(userName) + "$"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "by_user_name.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig() string {
+	return `data "awsteam_users" "test" {}`
+}
+
+func testAccUsersDataSourceConfig_nameRegex(nameRegex string) string {
+	return fmt.Sprintf(`
+data "awsteam_users" "test" {
+  name_regex = %q
+}
+`, nameRegex)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = validRegexValidator{}
+
+// validRegexValidator validates that a string is a regular expression that can be compiled.
+type validRegexValidator struct{}
+
+func ValidRegex() validator.String {
+	return validRegexValidator{}
+}
+
+func (v validRegexValidator) Description(ctx context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v validRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validRegexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Unable to compile %q, got error: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+var _ validator.String = validRFC3339Validator{}
+
+// validRFC3339Validator validates that a string is a timestamp in RFC 3339 format.
+type validRFC3339Validator struct{}
+
+func ValidRFC3339() validator.String {
+	return validRFC3339Validator{}
+}
+
+func (v validRFC3339Validator) Description(ctx context.Context) string {
+	return "value must be a valid RFC 3339 timestamp"
+}
+
+func (v validRFC3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validRFC3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid RFC 3339 Timestamp",
+			fmt.Sprintf("Unable to parse %q, got error: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
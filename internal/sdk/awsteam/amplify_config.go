@@ -0,0 +1,97 @@
+package awsteam
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// An AmplifyConfig holds the settings of a TEAM deployment read from the aws-exports.js or
+// amplify_outputs.json file generated by Amplify.
+type AmplifyConfig struct {
+	// The AppSync graph endpoint
+	GraphEndpoint string
+
+	// The region of the AppSync API
+	Region string
+
+	// The domain of the Cognito hosted UI, such as team-123456789012.auth.us-east-1.amazoncognito.com
+	CognitoDomain string
+
+	// The oauth2 token endpoint of the Cognito domain
+	TokenEndpoint string
+}
+
+// The fields read from the aws-exports.js object (Amplify Gen 1) and amplify_outputs.json (Amplify Gen 2).
+type amplifyConfigFile struct {
+	AppSyncGraphQLEndpoint string `json:"aws_appsync_graphqlEndpoint"`
+	AppSyncRegion          string `json:"aws_appsync_region"`
+	ProjectRegion          string `json:"aws_project_region"`
+	OAuth                  struct {
+		Domain string `json:"domain"`
+	} `json:"oauth"`
+
+	Data struct {
+		URL       string `json:"url"`
+		AWSRegion string `json:"aws_region"`
+	} `json:"data"`
+	Auth struct {
+		OAuth struct {
+			Domain string `json:"domain"`
+		} `json:"oauth"`
+	} `json:"auth"`
+}
+
+// LoadAmplifyConfig reads the deployment settings from an aws-exports.js or amplify_outputs.json file.
+// Settings that are not in the file are left empty.
+func LoadAmplifyConfig(path string) (*AmplifyConfig, error) {
+	contents, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAmplifyConfig(contents)
+}
+
+func parseAmplifyConfig(contents []byte) (*AmplifyConfig, error) {
+	// aws-exports.js assigns the settings to a variable and exports it, so only the object literal,
+	// which is generated as JSON, is decoded.
+	start := bytes.IndexByte(contents, '{')
+	end := bytes.LastIndexByte(contents, '}')
+
+	if start < 0 || end < start {
+		return nil, errors.New("no configuration object found")
+	}
+
+	file := &amplifyConfigFile{}
+
+	if err := json.Unmarshal(contents[start:end+1], file); err != nil {
+		return nil, fmt.Errorf("decoding configuration object: %w", err)
+	}
+
+	config := &AmplifyConfig{
+		GraphEndpoint: firstNonEmpty(file.AppSyncGraphQLEndpoint, file.Data.URL),
+		Region:        firstNonEmpty(file.AppSyncRegion, file.Data.AWSRegion, file.ProjectRegion),
+		CognitoDomain: firstNonEmpty(file.OAuth.Domain, file.Auth.OAuth.Domain),
+	}
+
+	if config.CognitoDomain != "" {
+		config.TokenEndpoint = "https://" + strings.TrimPrefix(config.CognitoDomain, "https://") + "/oauth2/token"
+	}
+
+	return config, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,51 @@
+package awsteam
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadAmplifyConfig(t *testing.T) {
+	testCases := map[string]AmplifyConfig{
+		"aws-exports.js": {
+			GraphEndpoint: "https://abcdefghijklmnopqrstuvwxyz.appsync-api.us-east-1.amazonaws.com/graphql",
+			Region:        "us-east-1",
+			CognitoDomain: "team-main-123456789012.auth.us-east-1.amazoncognito.com",
+			TokenEndpoint: "https://team-main-123456789012.auth.us-east-1.amazoncognito.com/oauth2/token",
+		},
+		"amplify_outputs.json": {
+			GraphEndpoint: "https://abcdefghijklmnopqrstuvwxyz.appsync-api.eu-west-2.amazonaws.com/graphql",
+			Region:        "eu-west-2",
+			CognitoDomain: "team-prod.auth.eu-west-2.amazoncognito.com",
+			TokenEndpoint: "https://team-prod.auth.eu-west-2.amazoncognito.com/oauth2/token",
+		},
+	}
+
+	for file, expected := range testCases {
+		file, expected := file, expected
+
+		t.Run(file, func(t *testing.T) {
+			config, err := LoadAmplifyConfig(filepath.Join("testdata", file))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if *config != expected {
+				t.Errorf("expected %+v, got %+v", expected, *config)
+			}
+		})
+	}
+}
+
+func TestLoadAmplifyConfig_errors(t *testing.T) {
+	if _, err := LoadAmplifyConfig(filepath.Join("testdata", "does_not_exist.json")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+
+	for _, contents := range []string{"export default {};\n// }", "const awsmobile = 42;", `{"data": {"url": 1}}`} {
+		if _, err := parseAmplifyConfig([]byte(contents)); err == nil {
+			t.Errorf("expected an error parsing %q", contents)
+		}
+	}
+}
@@ -2,11 +2,7 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-
-	"github.com/aws/smithy-go/ptr"
 )
 
 type CreateApproversInput struct {
@@ -30,30 +26,12 @@ func (client *Client) CreateApprovers(ctx context.Context, in *CreateApproversIn
 		return nil, errors.New("Id is required to create Approvers.")
 	}
 
-	approversJson, err := json.Marshal(in.Approvers)
-
-	if err != nil {
-		return nil, err
-	}
-
-	groupIdsJson, err := json.Marshal(in.GroupIds)
-
-	if err != nil {
-		return nil, err
+	variables := map[string]interface{}{
+		"input": *in,
 	}
 
-	q := fmt.Sprintf(`mutation CreateApprovers {
-		createApprovers(
-			input: {
-				id: "%s"
-				name: "%s"
-				type: "%s"
-				approvers: %s
-				groupIds: %s
-				ticketNo: "%s"
-				modifiedBy: "%s"
-			}
-		)  {
+	q := `mutation CreateApprovers($input: CreateApproversInput!) {
+		createApprovers(input: $input) {
 			id
 			name
 			type
@@ -64,22 +42,9 @@ func (client *Client) CreateApprovers(ctx context.Context, in *CreateApproversIn
 			createdAt
 			updatedAt
 		}
-	}`, ptr.ToString(in.Id),
-		ptr.ToString(in.Name),
-		ptr.ToString(in.Type),
-		string(approversJson),
-		string(groupIdsJson),
-		ptr.ToString(in.TicketNo),
-		ptr.ToString(in.ModifiedBy),
-	)
-
-	raw, err := client.GraphClient.ExecRaw(ctx, q, nil)
-
-	if err != nil {
-		return nil, err
-	}
+	}`
 
-	err = json.Unmarshal(raw, out)
+	err := client.exec(ctx, q, variables, out)
 
 	if err != nil {
 		return nil, err
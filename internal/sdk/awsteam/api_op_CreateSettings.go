@@ -2,28 +2,26 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
 	"github.com/aws/smithy-go/ptr"
 )
 
 type CreateSettingsInput struct {
-	Approval                  *bool
-	Comments                  *bool
-	Duration                  *int64
-	Expiry                    *int64
-	Id                        *string
-	SesNotificationsEnabled   *bool
-	SnsNotificationsEnabled   *bool
-	SlackNotificationsEnabled *bool
-	SesSourceEmail            *string
-	SesSourceArn              *string
-	SlackToken                *string
-	TeamAdminGroup            *string
-	TeamAuditorGroup          *string
-	TicketNo                  *bool
-	ModifiedBy                *string
+	Approval                  *bool   `json:"approval"`
+	Comments                  *bool   `json:"comments"`
+	Duration                  *int64  `json:"duration,string"`
+	Expiry                    *int64  `json:"expiry,string"`
+	Id                        *string `json:"id"`
+	SesNotificationsEnabled   *bool   `json:"sesNotificationsEnabled"`
+	SnsNotificationsEnabled   *bool   `json:"snsNotificationsEnabled"`
+	SlackNotificationsEnabled *bool   `json:"slackNotificationsEnabled"`
+	SesSourceEmail            *string `json:"sesSourceEmail"`
+	SesSourceArn              *string `json:"sesSourceArn"`
+	SlackToken                *string `json:"slackToken"`
+	TeamAdminGroup            *string `json:"teamAdminGroup"`
+	TeamAuditorGroup          *string `json:"teamAuditorGroup"`
+	TicketNo                  *bool   `json:"ticketNo"`
+	ModifiedBy                *string `json:"modifiedBy"`
 }
 
 type CreateSettingsOutput struct {
@@ -32,34 +30,19 @@ type CreateSettingsOutput struct {
 
 func (client *Client) CreateSettings(ctx context.Context, in *CreateSettingsInput) (*CreateSettingsOutput, error) {
 	out := &CreateSettingsOutput{}
-	var id string
 
-	if in.Id != nil {
-		id = *in.Id
-	} else {
-		id = "settings"
+	input := *in
+
+	if input.Id == nil {
+		input.Id = ptr.String("settings")
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
 	}
 
-	q := fmt.Sprintf(`mutation CreateSettings {
-		createSettings(
-			input: {
-				id: "%s"
-				duration: "%d"
-				expiry: "%d"
-				comments: %t
-				ticketNo: %t
-				approval: %t
-				modifiedBy: "%s"
-				sesNotificationsEnabled: %t
-				snsNotificationsEnabled: %t
-				slackNotificationsEnabled: %t
-				sesSourceEmail: "%s"
-				sesSourceArn: "%s"
-				slackToken: "%s"
-				teamAdminGroup: "%s"
-				teamAuditorGroup: "%s"
-			}
-		) {
+	q := `mutation CreateSettings($input: CreateSettingsInput!) {
+		createSettings(input: $input) {
 			id
 			duration
 			expiry
@@ -78,30 +61,9 @@ func (client *Client) CreateSettings(ctx context.Context, in *CreateSettingsInpu
 			createdAt
 			updatedAt
 		}
-	}
-	`, id, ptr.ToInt64(in.Duration),
-		ptr.ToInt64(in.Expiry),
-		ptr.ToBool(in.Comments),
-		ptr.ToBool(in.TicketNo),
-		ptr.ToBool(in.Approval),
-		ptr.ToString(in.ModifiedBy),
-		ptr.ToBool(in.SesNotificationsEnabled),
-		ptr.ToBool(in.SnsNotificationsEnabled),
-		ptr.ToBool(in.SlackNotificationsEnabled),
-		ptr.ToString(in.SesSourceEmail),
-		ptr.ToString(in.SesSourceArn),
-		ptr.ToString(in.SlackToken),
-		ptr.ToString(in.TeamAdminGroup),
-		ptr.ToString(in.TeamAuditorGroup),
-	)
-
-	raw, err := client.GraphClient.ExecRaw(ctx, q, nil)
-
-	if err != nil {
-		return nil, err
-	}
+	}`
 
-	err = json.Unmarshal(raw, out)
+	err := client.exec(ctx, q, variables, out)
 
 	if err != nil {
 		return nil, err
@@ -2,15 +2,11 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-
-	"github.com/aws/smithy-go/ptr"
 )
 
 type DeleteApproversInput struct {
-	Id *string
+	Id *string `json:"id"`
 }
 
 type DeleteApproversOutput struct {
@@ -24,23 +20,20 @@ func (client *Client) DeleteApprovers(ctx context.Context, in *DeleteApproversIn
 		return nil, errors.New("Id is required to delete Approvers.")
 	}
 
-	q := fmt.Sprintf(`mutation DeleteApprovers {
-		deleteApprovers(input: { id: "%s" }) {
+	variables := map[string]interface{}{
+		"input": *in,
+	}
+
+	q := `mutation DeleteApprovers($input: DeleteApproversInput!) {
+		deleteApprovers(input: $input) {
 			id
 		}
-	}	
-	`, ptr.ToString(in.Id))
-
-	raw, err := client.GraphClient.ExecRaw(ctx, q, nil)
-
-	if err != nil {
-		return nil, err
-	}
+	}`
 
-	err = json.Unmarshal(raw, out)
+	err := client.exec(ctx, q, variables, out)
 
 	if err != nil {
-		return nil, err
+		return nil, notFoundOnConditionFailed(err)
 	}
 
 	return out, nil
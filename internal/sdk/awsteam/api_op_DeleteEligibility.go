@@ -2,15 +2,11 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-
-	"github.com/aws/smithy-go/ptr"
 )
 
 type DeleteEligibilityInput struct {
-	Id *string
+	Id *string `json:"id"`
 }
 
 type DeleteEligibilityOutput struct {
@@ -24,23 +20,20 @@ func (client *Client) DeleteEligibility(ctx context.Context, in *DeleteEligibili
 		return nil, errors.New("Id is required to delete Eligibility.")
 	}
 
-	q := fmt.Sprintf(`mutation DeleteEligibility {
-		deleteEligibility(input: { id: "%s" }) {
+	variables := map[string]interface{}{
+		"input": *in,
+	}
+
+	q := `mutation DeleteEligibility($input: DeleteEligibilityInput!) {
+		deleteEligibility(input: $input) {
 			id
 		}
-	}	
-	`, ptr.ToString(in.Id))
-
-	raw, err := client.GraphClient.ExecRaw(ctx, q, nil)
-
-	if err != nil {
-		return nil, err
-	}
+	}`
 
-	err = json.Unmarshal(raw, out)
+	err := client.exec(ctx, q, variables, out)
 
 	if err != nil {
-		return nil, err
+		return nil, notFoundOnConditionFailed(err)
 	}
 
 	return out, nil
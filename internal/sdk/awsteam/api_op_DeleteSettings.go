@@ -2,12 +2,12 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+
+	"github.com/aws/smithy-go/ptr"
 )
 
 type DeleteSettingsInput struct {
-	Id *string
+	Id *string `json:"id"`
 }
 
 type DeleteSettingsOutput struct {
@@ -16,31 +16,27 @@ type DeleteSettingsOutput struct {
 
 func (client *Client) DeleteSettings(ctx context.Context, in *DeleteSettingsInput) (*DeleteSettingsOutput, error) {
 	out := &DeleteSettingsOutput{}
-	var id string
 
-	if in.Id != nil {
-		id = *in.Id
-	} else {
-		id = "settings"
+	input := *in
+
+	if input.Id == nil {
+		input.Id = ptr.String("settings")
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
 	}
 
-	q := fmt.Sprintf(`mutation DeleteSettings {
-		deleteSettings(input: { id: "%s" }) {
+	q := `mutation DeleteSettings($input: DeleteSettingsInput!) {
+		deleteSettings(input: $input) {
 			id
 		}
-	}	
-	`, id)
-
-	raw, err := client.GraphClient.ExecRaw(ctx, q, nil)
-
-	if err != nil {
-		return nil, err
-	}
+	}`
 
-	err = json.Unmarshal(raw, out)
+	err := client.exec(ctx, q, variables, out)
 
 	if err != nil {
-		return nil, err
+		return nil, notFoundOnConditionFailed(err)
 	}
 
 	return out, nil
@@ -2,7 +2,6 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
 )
 
 type GetAccountsInput struct{}
@@ -21,13 +20,7 @@ func (client *Client) GetAccounts(ctx context.Context, in *GetAccountsInput) (*G
 		}
 	}`
 
-	raw, err := client.GraphClient.ExecRaw(ctx, q, nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(raw, out)
+	err := client.exec(ctx, q, nil, out)
 
 	if err != nil {
 		return nil, err
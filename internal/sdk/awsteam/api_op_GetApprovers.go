@@ -2,15 +2,11 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-
-	"github.com/aws/smithy-go/ptr"
 )
 
 type GetApproversInput struct {
-	Id *string
+	Id *string `json:"id"`
 }
 
 type GetApproversOutput struct {
@@ -24,8 +20,12 @@ func (client *Client) GetApprovers(ctx context.Context, in *GetApproversInput) (
 		return nil, errors.New("Id is required to get Approvers.")
 	}
 
-	q := fmt.Sprintf(`query GetApprovers {
-		getApprovers(id: "%s") {
+	variables := map[string]interface{}{
+		"id": in.Id,
+	}
+
+	q := `query GetApprovers($id: ID!) {
+		getApprovers(id: $id) {
 			id
 			name
 			type
@@ -36,19 +36,16 @@ func (client *Client) GetApprovers(ctx context.Context, in *GetApproversInput) (
 			createdAt
 			updatedAt
 		}
-	}	
-	`, ptr.ToString(in.Id))
+	}`
 
-	raw, err := client.GraphClient.ExecRaw(ctx, q, nil)
+	err := client.exec(ctx, q, variables, out)
 
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(raw, out)
-
-	if err != nil {
-		return nil, err
+	if out.Approvers == nil {
+		return nil, newNotFoundError("Approvers", in.Id)
 	}
 
 	return out, nil
@@ -2,15 +2,11 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-
-	"github.com/aws/smithy-go/ptr"
 )
 
 type GetEligibilityInput struct {
-	Id *string
+	Id *string `json:"id"`
 }
 
 type GetEligibilityOutput struct {
@@ -24,8 +20,12 @@ func (client *Client) GetEligibility(ctx context.Context, in *GetEligibilityInpu
 		return nil, errors.New("Id is required to get Eligibility.")
 	}
 
-	q := fmt.Sprintf(`query GetEligibility {
-		getEligibility(id: "%s") {
+	variables := map[string]interface{}{
+		"id": in.Id,
+	}
+
+	q := `query GetEligibility($id: ID!) {
+		getEligibility(id: $id) {
 			id
 			name
 			type
@@ -48,19 +48,16 @@ func (client *Client) GetEligibility(ctx context.Context, in *GetEligibilityInpu
 				id
 			}
 		}
-	}	
-	`, ptr.ToString(in.Id))
+	}`
 
-	raw, err := client.GraphClient.ExecRaw(ctx, q, nil)
+	err := client.exec(ctx, q, variables, out)
 
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(raw, out)
-
-	if err != nil {
-		return nil, err
+	if out.Eligibility == nil {
+		return nil, newNotFoundError("Eligibility", in.Id)
 	}
 
 	return out, nil
@@ -0,0 +1,46 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+)
+
+type GetGroupMembershipsInput struct {
+	UserId *string // The IAM Identity Center user id
+}
+
+type GetGroupMembershipsOutput struct {
+	GroupIds []*string `json:"groupIds"` // Ids of the groups the user is a member of
+}
+
+func (client *Client) GetGroupMemberships(ctx context.Context, in *GetGroupMembershipsInput) (*GetGroupMembershipsOutput, error) {
+	out := &struct {
+		Memberships *GetGroupMembershipsOutput `json:"getGroupMemberships"`
+	}{}
+
+	if in.UserId == nil {
+		return nil, errors.New("UserId is required to get Group Memberships.")
+	}
+
+	variables := map[string]interface{}{
+		"userId": in.UserId,
+	}
+
+	q := `query GetGroupMemberships($userId: String) {
+		getGroupMemberships(userId: $userId) {
+			groupIds
+		}
+	}`
+
+	err := client.exec(ctx, q, variables, out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Memberships == nil {
+		return nil, newNotFoundError("User", in.UserId)
+	}
+
+	return out.Memberships, nil
+}
@@ -0,0 +1,43 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func TestGetGroupMemberships(t *testing.T) {
+	client := newFixtureClient(t, "get_group_memberships.json")
+
+	out, err := client.GetGroupMemberships(context.Background(), &GetGroupMembershipsInput{UserId: ptr.String("d78686b5-bb78-471c-8b2f-817e70e3158b")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{"906720e0-d041-70f4-a3b2-5c6d7e8f9a0b", "b4e8a4d8-5061-7065-1f2e-3d4c5b6a7980"}
+
+	if len(out.GroupIds) != len(expected) {
+		t.Fatalf("expected %d group ids, got %d", len(expected), len(out.GroupIds))
+	}
+
+	for i, id := range expected {
+		if ptr.ToString(out.GroupIds[i]) != id {
+			t.Errorf("group %d: expected %s, got %s", i, id, ptr.ToString(out.GroupIds[i]))
+		}
+	}
+}
+
+func TestGetGroupMemberships_notFound(t *testing.T) {
+	client := newTestClient(newStaticServer(t, http.StatusOK, `{"data":{"getGroupMemberships":null}}`))
+
+	_, err := client.GetGroupMemberships(context.Background(), &GetGroupMembershipsInput{UserId: ptr.String("missing")})
+
+	var target *NotFoundError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected NotFoundError, got %T: %v", err, err)
+	}
+}
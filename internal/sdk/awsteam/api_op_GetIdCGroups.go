@@ -0,0 +1,30 @@
+package awsteam
+
+import (
+	"context"
+)
+
+type GetIdCGroupsInput struct{}
+
+type GetIdCGroupsOutput struct {
+	Groups []*Group `json:"getIdCGroups"` // IAM Identity Center groups
+}
+
+func (client *Client) GetIdCGroups(ctx context.Context, in *GetIdCGroupsInput) (*GetIdCGroupsOutput, error) {
+	out := &GetIdCGroupsOutput{}
+
+	q := `query GetIdCGroups {
+		getIdCGroups {
+			GroupId
+			DisplayName
+		}
+	}`
+
+	err := client.exec(ctx, q, nil, out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,26 @@
+package awsteam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func TestGetIdCGroups(t *testing.T) {
+	client := newFixtureClient(t, "get_idc_groups.json")
+
+	out, err := client.GetIdCGroups(context.Background(), &GetIdCGroupsInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(out.Groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(out.Groups))
+	}
+
+	if ptr.ToString(out.Groups[1].Id) != "b4e8a4d8-5061-7065-1f2e-3d4c5b6a7980" || ptr.ToString(out.Groups[1].DisplayName) != "developers" {
+		t.Errorf("unexpected group: %+v", out.Groups[1])
+	}
+}
@@ -0,0 +1,56 @@
+package awsteam
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type GetOUInput struct {
+	Id *string // The id of the OU or organization root
+}
+
+type GetOUOutput struct {
+	Accounts []*Account // Accounts directly in the OU, excluding those in child OUs
+}
+
+func (client *Client) GetOU(ctx context.Context, in *GetOUInput) (*GetOUOutput, error) {
+	out := &struct {
+		GetOU *struct {
+			Accounts *string `json:"accounts"` // AWSJSON encoded list of accounts
+		} `json:"getOU"`
+	}{}
+
+	variables := map[string]interface{}{
+		"id": in.Id,
+	}
+
+	q := `query GetOU($id: String) {
+		getOU(id: $id) {
+			accounts
+		}
+	}`
+
+	err := client.exec(ctx, q, variables, out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.GetOU == nil {
+		return nil, newNotFoundError("OU", in.Id)
+	}
+
+	accounts := []*Account{}
+
+	if out.GetOU.Accounts == nil {
+		return &GetOUOutput{Accounts: accounts}, nil
+	}
+
+	err = json.Unmarshal([]byte(*out.GetOU.Accounts), &accounts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetOUOutput{Accounts: accounts}, nil
+}
@@ -0,0 +1,39 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func TestGetOU(t *testing.T) {
+	client := newFixtureClient(t, "get_ou.json")
+
+	out, err := client.GetOU(context.Background(), &GetOUInput{Id: ptr.String("ou-a1b2-22222222")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(out.Accounts) != 2 {
+		t.Fatalf("expected 2 accounts, got %d", len(out.Accounts))
+	}
+
+	if ptr.ToString(out.Accounts[0].Id) != "222222222222" || ptr.ToString(out.Accounts[0].Name) != "prod-app" {
+		t.Errorf("unexpected account: %+v", out.Accounts[0])
+	}
+}
+
+func TestGetOU_notFound(t *testing.T) {
+	client := newTestClient(newStaticServer(t, http.StatusOK, `{"data":{"getOU":null}}`))
+
+	_, err := client.GetOU(context.Background(), &GetOUInput{Id: ptr.String("ou-missing-00000000")})
+
+	var target *NotFoundError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected NotFoundError, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,72 @@
+package awsteam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+type GetOUsInput struct{}
+
+type GetOUsOutput struct {
+	OUs []OU
+}
+
+func (client *Client) GetOUs(ctx context.Context, in *GetOUsInput) (*GetOUsOutput, error) {
+	out := &struct {
+		GetOUs *struct {
+			OUs *string `json:"ous"` // AWSJSON encoded organization tree
+		} `json:"getOUs"`
+	}{}
+
+	q := `query GetOUs {
+		getOUs {
+			ous
+		}
+	}`
+
+	err := client.exec(ctx, q, nil, out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.GetOUs == nil || out.GetOUs.OUs == nil {
+		return &GetOUsOutput{OUs: []OU{}}, nil
+	}
+
+	ous, err := decodeOUs([]byte(*out.GetOUs.OUs))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetOUsOutput{OUs: ous}, nil
+}
+
+// decodeOUs decodes the organization tree, which is either the root OU or a list of top level OUs.
+func decodeOUs(raw []byte) ([]OU, error) {
+	raw = bytes.TrimSpace(raw)
+
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return []OU{}, nil
+	}
+
+	if raw[0] != '[' {
+		root := OU{}
+
+		if err := json.Unmarshal(raw, &root); err != nil {
+			return nil, err
+		}
+
+		return []OU{root}, nil
+	}
+
+	ous := []OU{}
+
+	if err := json.Unmarshal(raw, &ous); err != nil {
+		return nil, err
+	}
+
+	return ous, nil
+}
@@ -0,0 +1,114 @@
+package awsteam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func TestGetOUs(t *testing.T) {
+	testCases := map[string]struct {
+		fixture  string
+		topLevel int
+		total    int
+	}{
+		"root": {
+			fixture:  "get_ous.json",
+			topLevel: 1,
+			total:    6,
+		},
+		"list": {
+			fixture:  "get_ous_list.json",
+			topLevel: 2,
+			total:    5,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			client := newFixtureClient(t, tc.fixture)
+
+			out, err := client.GetOUs(context.Background(), &GetOUsInput{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(out.OUs) != tc.topLevel {
+				t.Errorf("expected %d top level OUs, got %d", tc.topLevel, len(out.OUs))
+			}
+
+			total := 0
+			WalkOUs(out.OUs, func(ou *OU, _ []*OU) bool {
+				total++
+				return true
+			})
+
+			if total != tc.total {
+				t.Errorf("expected %d OUs, got %d", tc.total, total)
+			}
+
+			prod := FindOUById(out.OUs, "ou-a1b2-22222222")
+
+			if prod == nil {
+				t.Fatal("expected to find OU ou-a1b2-22222222")
+			}
+
+			if ptr.ToString(prod.Name) != "Prod" || ptr.ToString(prod.Arn) != "arn:aws:organizations::111122223333:ou/o-exampleorgid/ou-a1b2-22222222" {
+				t.Errorf("unexpected OU: %+v", prod)
+			}
+		})
+	}
+}
+
+func TestOUTreeHelpers(t *testing.T) {
+	client := newFixtureClient(t, "get_ous.json")
+
+	out, err := client.GetOUs(context.Background(), &GetOUsInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ou := FindOUById(out.OUs, "ou-missing-00000000"); ou != nil {
+		t.Errorf("expected no OU, got %+v", ou)
+	}
+
+	if ous := FindOUsByName(out.OUs, "Prod"); len(ous) != 2 {
+		t.Errorf("expected 2 OUs named Prod, got %d", len(ous))
+	}
+
+	if ous := FindOUsByName(out.OUs, "Missing"); len(ous) != 0 {
+		t.Errorf("expected no OUs named Missing, got %d", len(ous))
+	}
+
+	path := OUPath(out.OUs, "ou-a1b2-55555555")
+	expected := []string{"r-a1b2", "ou-a1b2-44444444", "ou-a1b2-55555555"}
+
+	if len(path) != len(expected) {
+		t.Fatalf("expected path of %d OUs, got %d", len(expected), len(path))
+	}
+
+	for i, id := range expected {
+		if ptr.ToString(path[i].Id) != id {
+			t.Errorf("path %d: expected %s, got %s", i, id, ptr.ToString(path[i].Id))
+		}
+	}
+
+	if path := OUPath(out.OUs, "ou-missing-00000000"); path != nil {
+		t.Errorf("expected no path, got %d OUs", len(path))
+	}
+
+	visited := 0
+	WalkOUs(out.OUs, func(ou *OU, _ []*OU) bool {
+		visited++
+		return ptr.ToString(ou.Id) != "ou-a1b2-11111111"
+	})
+
+	if visited != 2 {
+		t.Errorf("expected walking to stop after 2 OUs, visited %d", visited)
+	}
+}
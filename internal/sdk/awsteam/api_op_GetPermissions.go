@@ -0,0 +1,46 @@
+package awsteam
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type GetPermissionsInput struct{}
+
+type GetPermissionsOutput struct {
+	Permissions []*Permission
+}
+
+func (client *Client) GetPermissions(ctx context.Context, in *GetPermissionsInput) (*GetPermissionsOutput, error) {
+	out := &struct {
+		GetPermissions *struct {
+			Permissions *string `json:"permissions"` // AWSJSON encoded list of permission sets
+		} `json:"getPermissions"`
+	}{}
+
+	q := `query GetPermissions {
+		getPermissions {
+			permissions
+		}
+	}`
+
+	err := client.exec(ctx, q, nil, out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := []*Permission{}
+
+	if out.GetPermissions == nil || out.GetPermissions.Permissions == nil {
+		return &GetPermissionsOutput{Permissions: permissions}, nil
+	}
+
+	err = json.Unmarshal([]byte(*out.GetPermissions.Permissions), &permissions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetPermissionsOutput{Permissions: permissions}, nil
+}
@@ -0,0 +1,79 @@
+package awsteam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func TestGetPermissions(t *testing.T) {
+	client := newFixtureClient(t, "get_permissions.json")
+
+	out, err := client.GetPermissions(context.Background(), &GetPermissionsInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(out.Permissions) != 3 {
+		t.Fatalf("expected 3 permissions, got %d", len(out.Permissions))
+	}
+
+	readOnly := out.Permissions[1]
+
+	if ptr.ToString(readOnly.Name) != "ReadOnlyAccess" || ptr.ToString(readOnly.Arn) != "arn:aws:sso:::permissionSet/ssoins-7223a1b2c3d4e5f6/ps-2b3c4d5e6f7a8b9c" {
+		t.Errorf("unexpected permission: %+v", readOnly)
+	}
+
+	duration, err := readOnly.SessionDuration()
+
+	if err != nil {
+		t.Fatalf("unexpected error parsing duration: %s", err)
+	}
+
+	if duration != 8*time.Hour+30*time.Minute {
+		t.Errorf("expected 8h30m, got %s", duration)
+	}
+}
+
+func TestParseISO8601Duration(t *testing.T) {
+	testCases := map[string]time.Duration{
+		"PT1H":      time.Hour,
+		"PT12H":     12 * time.Hour,
+		"PT8H30M":   8*time.Hour + 30*time.Minute,
+		"PT90M":     90 * time.Minute,
+		"PT45S":     45 * time.Second,
+		"PT1.5S":    1500 * time.Millisecond,
+		"P1D":       24 * time.Hour,
+		"P1DT2H":    26 * time.Hour,
+		"PT1H1M1S":  time.Hour + time.Minute + time.Second,
+		"P0D":       0,
+		"PT0H0M10S": 10 * time.Second,
+	}
+
+	for s, expected := range testCases {
+		got, err := parseISO8601Duration(s)
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", s, err)
+			continue
+		}
+
+		if got != expected {
+			t.Errorf("%s: expected %s, got %s", s, expected, got)
+		}
+	}
+
+	for _, s := range []string{"", "P", "PT", "1H", "PT1X", "P1Y", "P1M", "PT-1H", "P1DT"} {
+		if _, err := parseISO8601Duration(s); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+
+	var unset Permission
+	if d, err := unset.SessionDuration(); d != 0 || err != nil {
+		t.Errorf("expected no duration for an unset permission, got %s, %v", d, err)
+	}
+}
@@ -2,12 +2,12 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+
+	"github.com/aws/smithy-go/ptr"
 )
 
 type GetSettingsInput struct {
-	Id *string
+	Id *string `json:"id"`
 }
 
 type GetSettingsOutput struct {
@@ -16,16 +16,19 @@ type GetSettingsOutput struct {
 
 func (client *Client) GetSettings(ctx context.Context, in *GetSettingsInput) (*GetSettingsOutput, error) {
 	out := &GetSettingsOutput{}
-	var id string
 
-	if in.Id != nil {
-		id = *in.Id
-	} else {
-		id = "settings"
+	input := *in
+
+	if input.Id == nil {
+		input.Id = ptr.String("settings")
+	}
+
+	variables := map[string]interface{}{
+		"id": input.Id,
 	}
 
-	q := fmt.Sprintf(`query GetSettings {
-		getSettings(id: "%s") {
+	q := `query GetSettings($id: ID!) {
+		getSettings(id: $id) {
 			id
 			duration
 			expiry
@@ -44,19 +47,16 @@ func (client *Client) GetSettings(ctx context.Context, in *GetSettingsInput) (*G
 			createdAt
 			updatedAt
 		}
-	}	
-	`, id)
+	}`
 
-	raw, err := client.GraphClient.ExecRaw(ctx, q, nil)
+	err := client.exec(ctx, q, variables, out)
 
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(raw, out)
-
-	if err != nil {
-		return nil, err
+	if out.Settings == nil {
+		return nil, newNotFoundError("Settings", input.Id)
 	}
 
 	return out, nil
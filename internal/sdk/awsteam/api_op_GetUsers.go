@@ -0,0 +1,30 @@
+package awsteam
+
+import (
+	"context"
+)
+
+type GetUsersInput struct{}
+
+type GetUsersOutput struct {
+	Users []*User `json:"getUsers"` // IAM Identity Center users
+}
+
+func (client *Client) GetUsers(ctx context.Context, in *GetUsersInput) (*GetUsersOutput, error) {
+	out := &GetUsersOutput{}
+
+	q := `query GetUsers {
+		getUsers {
+			UserId
+			UserName
+		}
+	}`
+
+	err := client.exec(ctx, q, nil, out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,26 @@
+package awsteam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func TestGetUsers(t *testing.T) {
+	client := newFixtureClient(t, "get_users.json")
+
+	out, err := client.GetUsers(context.Background(), &GetUsersInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(out.Users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(out.Users))
+	}
+
+	if ptr.ToString(out.Users[0].Id) != "d78686b5-bb78-471c-8b2f-817e70e3158b" || ptr.ToString(out.Users[0].UserName) != "jane@contoso.com" {
+		t.Errorf("unexpected user: %+v", out.Users[0])
+	}
+}
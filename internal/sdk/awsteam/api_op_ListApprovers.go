@@ -0,0 +1,146 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+)
+
+type ListApproversInput struct {
+	Type      *string // Only return approver policies of this type, "Account" or "OU"
+	Limit     *int32
+	NextToken *string
+}
+
+type ListApproversOutput struct {
+	Approvers []*Approvers `json:"items"`
+	NextToken *string      `json:"nextToken"`
+}
+
+func (client *Client) ListApprovers(ctx context.Context, in *ListApproversInput) (*ListApproversOutput, error) {
+	out := &struct {
+		List *ListApproversOutput `json:"listApproverss"`
+	}{}
+
+	variables := map[string]interface{}{
+		"limit":     in.Limit,
+		"nextToken": in.NextToken,
+	}
+
+	filter := map[string]interface{}{}
+
+	if in.Type != nil {
+		filter["type"] = map[string]interface{}{"eq": in.Type}
+	}
+
+	if len(filter) > 0 {
+		variables["filter"] = filter
+	}
+
+	q := `query ListApproverss($filter: ModelApproversFilterInput, $limit: Int, $nextToken: String) {
+		listApproverss(filter: $filter, limit: $limit, nextToken: $nextToken) {
+			items {
+				id
+				name
+				type
+				approvers
+				groupIds
+				ticketNo
+				modifiedBy
+				createdAt
+				updatedAt
+			}
+			nextToken
+		}
+	}`
+
+	err := client.exec(ctx, q, variables, out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.List == nil {
+		return &ListApproversOutput{}, nil
+	}
+
+	return out.List, nil
+}
+
+type ListApproversPaginatorOptions struct {
+	// The maximum number of approver policies to evaluate per page. Filters are applied after the
+	// limit, so a page can hold fewer items than this.
+	Limit int32
+
+	// Stop paginating if the service returns the same next token twice in a row.
+	StopOnDuplicateToken bool
+}
+
+// A ListApproversPaginator pages through the results of ListApprovers.
+type ListApproversPaginator struct {
+	options   ListApproversPaginatorOptions
+	client    *Client
+	params    *ListApproversInput
+	nextToken *string
+	firstPage bool
+}
+
+func NewListApproversPaginator(client *Client, params *ListApproversInput, optFns ...func(*ListApproversPaginatorOptions)) *ListApproversPaginator {
+	if params == nil {
+		params = &ListApproversInput{}
+	}
+
+	options := ListApproversPaginatorOptions{}
+
+	if params.Limit != nil {
+		options.Limit = *params.Limit
+	}
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	return &ListApproversPaginator{
+		options:   options,
+		client:    client,
+		params:    params,
+		nextToken: params.NextToken,
+		firstPage: true,
+	}
+}
+
+// HasMorePages returns true if there are more pages to retrieve.
+func (p *ListApproversPaginator) HasMorePages() bool {
+	return p.firstPage || (p.nextToken != nil && len(*p.nextToken) != 0)
+}
+
+// NextPage retrieves the next ListApprovers page.
+func (p *ListApproversPaginator) NextPage(ctx context.Context) (*ListApproversOutput, error) {
+	if !p.HasMorePages() {
+		return nil, errors.New("no more pages available")
+	}
+
+	params := *p.params
+	params.NextToken = p.nextToken
+
+	if p.options.Limit > 0 {
+		limit := p.options.Limit
+		params.Limit = &limit
+	}
+
+	result, err := p.client.ListApprovers(ctx, &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.firstPage = false
+
+	prevToken := p.nextToken
+	p.nextToken = result.NextToken
+
+	if p.options.StopOnDuplicateToken && prevToken != nil && p.nextToken != nil && *prevToken == *p.nextToken {
+		p.nextToken = nil
+	}
+
+	return result, nil
+}
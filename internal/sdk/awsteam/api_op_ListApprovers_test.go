@@ -0,0 +1,80 @@
+package awsteam
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func TestListApproversPaginator(t *testing.T) {
+	items := []map[string]interface{}{}
+	for i := 0; i < 3; i++ {
+		items = append(items, map[string]interface{}{
+			"id":        fmt.Sprintf("ou-abcd-%08d", i),
+			"name":      fmt.Sprintf("OU %d", i),
+			"type":      "OU",
+			"approvers": []string{"Approvers"},
+			"groupIds":  []string{"group-id"},
+		})
+	}
+
+	var requests []listRequest
+	client := newTestClient(newPagedServer(t, "listApproverss", items, 1, &requests))
+
+	paginator := NewListApproversPaginator(client, &ListApproversInput{Type: ptr.String("OU")})
+
+	var approvers []*Approvers
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		approvers = append(approvers, page.Approvers...)
+	}
+
+	if len(approvers) != len(items) {
+		t.Fatalf("expected %d approvers, got %d", len(items), len(approvers))
+	}
+
+	if ptr.ToString(approvers[2].Id) != "ou-abcd-00000002" || ptr.ToString(approvers[2].GroupIds[0]) != "group-id" {
+		t.Errorf("unexpected approvers: %+v", approvers[2])
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(requests))
+	}
+
+	for i, req := range requests {
+		if req.Variables.Filter["type"]["eq"] != "OU" {
+			t.Errorf("request %d: expected type filter, got %v", i, req.Variables.Filter)
+		}
+
+		if req.Variables.Limit != nil {
+			t.Errorf("request %d: expected no limit, got %d", i, *req.Variables.Limit)
+		}
+	}
+}
+
+func TestListApprovers_noFilter(t *testing.T) {
+	var requests []listRequest
+	client := newTestClient(newPagedServer(t, "listApproverss", []map[string]interface{}{}, 10, &requests))
+
+	out, err := client.ListApprovers(context.Background(), &ListApproversInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(out.Approvers) != 0 || out.NextToken != nil {
+		t.Errorf("expected an empty last page, got %+v", out)
+	}
+
+	if requests[0].Variables.Filter != nil {
+		t.Errorf("expected no filter, got %v", requests[0].Variables.Filter)
+	}
+}
@@ -0,0 +1,163 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+)
+
+type ListEligibilitiesInput struct {
+	Type      *string // Only return eligibilities of this type, "User" or "Group"
+	Name      *string // Only return eligibilities with this user or group name
+	Limit     *int32
+	NextToken *string
+}
+
+type ListEligibilitiesOutput struct {
+	Eligibilities []*Eligibility `json:"items"`
+	NextToken     *string        `json:"nextToken"`
+}
+
+func (client *Client) ListEligibilities(ctx context.Context, in *ListEligibilitiesInput) (*ListEligibilitiesOutput, error) {
+	out := &struct {
+		List *ListEligibilitiesOutput `json:"listEligibilitys"`
+	}{}
+
+	variables := map[string]interface{}{
+		"limit":     in.Limit,
+		"nextToken": in.NextToken,
+	}
+
+	filter := map[string]interface{}{}
+
+	if in.Type != nil {
+		filter["type"] = map[string]interface{}{"eq": in.Type}
+	}
+
+	if in.Name != nil {
+		filter["name"] = map[string]interface{}{"eq": in.Name}
+	}
+
+	if len(filter) > 0 {
+		variables["filter"] = filter
+	}
+
+	q := `query ListEligibilitys($filter: ModelEligibilityFilterInput, $limit: Int, $nextToken: String) {
+		listEligibilitys(filter: $filter, limit: $limit, nextToken: $nextToken) {
+			items {
+				id
+				name
+				type
+				ticketNo
+				approvalRequired
+				duration
+				modifiedBy
+				createdAt
+				updatedAt
+				accounts {
+					name
+					id
+				}
+				ous {
+					name
+					id
+				}
+				permissions {
+					name
+					id
+				}
+			}
+			nextToken
+		}
+	}`
+
+	err := client.exec(ctx, q, variables, out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.List == nil {
+		return &ListEligibilitiesOutput{}, nil
+	}
+
+	return out.List, nil
+}
+
+type ListEligibilitiesPaginatorOptions struct {
+	// The maximum number of eligibilities to evaluate per page. Filters are applied after the
+	// limit, so a page can hold fewer items than this.
+	Limit int32
+
+	// Stop paginating if the service returns the same next token twice in a row.
+	StopOnDuplicateToken bool
+}
+
+// A ListEligibilitiesPaginator pages through the results of ListEligibilities.
+type ListEligibilitiesPaginator struct {
+	options   ListEligibilitiesPaginatorOptions
+	client    *Client
+	params    *ListEligibilitiesInput
+	nextToken *string
+	firstPage bool
+}
+
+func NewListEligibilitiesPaginator(client *Client, params *ListEligibilitiesInput, optFns ...func(*ListEligibilitiesPaginatorOptions)) *ListEligibilitiesPaginator {
+	if params == nil {
+		params = &ListEligibilitiesInput{}
+	}
+
+	options := ListEligibilitiesPaginatorOptions{}
+
+	if params.Limit != nil {
+		options.Limit = *params.Limit
+	}
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	return &ListEligibilitiesPaginator{
+		options:   options,
+		client:    client,
+		params:    params,
+		nextToken: params.NextToken,
+		firstPage: true,
+	}
+}
+
+// HasMorePages returns true if there are more pages to retrieve.
+func (p *ListEligibilitiesPaginator) HasMorePages() bool {
+	return p.firstPage || (p.nextToken != nil && len(*p.nextToken) != 0)
+}
+
+// NextPage retrieves the next ListEligibilities page.
+func (p *ListEligibilitiesPaginator) NextPage(ctx context.Context) (*ListEligibilitiesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, errors.New("no more pages available")
+	}
+
+	params := *p.params
+	params.NextToken = p.nextToken
+
+	if p.options.Limit > 0 {
+		limit := p.options.Limit
+		params.Limit = &limit
+	}
+
+	result, err := p.client.ListEligibilities(ctx, &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.firstPage = false
+
+	prevToken := p.nextToken
+	p.nextToken = result.NextToken
+
+	if p.options.StopOnDuplicateToken && prevToken != nil && p.nextToken != nil && *prevToken == *p.nextToken {
+		p.nextToken = nil
+	}
+
+	return result, nil
+}
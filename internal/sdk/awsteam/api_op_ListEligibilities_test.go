@@ -0,0 +1,131 @@
+package awsteam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+type listRequest struct {
+	Variables struct {
+		Filter    map[string]map[string]string `json:"filter"`
+		Limit     *int32                       `json:"limit"`
+		NextToken *string                      `json:"nextToken"`
+	} `json:"variables"`
+}
+
+// newPagedServer returns a graph endpoint that serves the items for the list field in pages of
+// pageSize, recording the variables of each request.
+func newPagedServer(t *testing.T, field string, items []map[string]interface{}, pageSize int, requests *[]listRequest) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := listRequest{}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		*requests = append(*requests, req)
+
+		start := 0
+		if req.Variables.NextToken != nil {
+			_, _ = fmt.Sscanf(*req.Variables.NextToken, "page-%d", &start)
+		}
+
+		end := start + pageSize
+		var nextToken *string
+
+		if end < len(items) {
+			nextToken = ptr.String(fmt.Sprintf("page-%d", end))
+		} else {
+			end = len(items)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				field: map[string]interface{}{
+					"items":     items[start:end],
+					"nextToken": nextToken,
+				},
+			},
+		})
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestListEligibilitiesPaginator(t *testing.T) {
+	items := []map[string]interface{}{}
+	for i := 0; i < 5; i++ {
+		items = append(items, map[string]interface{}{
+			"id":       fmt.Sprintf("group-%d", i),
+			"name":     fmt.Sprintf("Group %d", i),
+			"type":     "Group",
+			"duration": "4",
+		})
+	}
+
+	var requests []listRequest
+	client := newTestClient(newPagedServer(t, "listEligibilitys", items, 2, &requests))
+
+	paginator := NewListEligibilitiesPaginator(client, &ListEligibilitiesInput{Type: ptr.String("Group")}, func(o *ListEligibilitiesPaginatorOptions) {
+		o.Limit = 2
+	})
+
+	var eligibilities []*Eligibility
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		eligibilities = append(eligibilities, page.Eligibilities...)
+	}
+
+	if len(eligibilities) != len(items) {
+		t.Fatalf("expected %d eligibilities, got %d", len(items), len(eligibilities))
+	}
+
+	if ptr.ToString(eligibilities[4].Id) != "group-4" || ptr.ToInt64(eligibilities[4].Duration) != 4 {
+		t.Errorf("unexpected eligibility: %+v", eligibilities[4])
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(requests))
+	}
+
+	for i, req := range requests {
+		if req.Variables.Filter["type"]["eq"] != "Group" {
+			t.Errorf("request %d: expected type filter, got %v", i, req.Variables.Filter)
+		}
+
+		if _, ok := req.Variables.Filter["name"]; ok {
+			t.Errorf("request %d: unexpected name filter", i)
+		}
+
+		if req.Variables.Limit == nil || *req.Variables.Limit != 2 {
+			t.Errorf("request %d: expected limit 2", i)
+		}
+	}
+
+	if requests[0].Variables.NextToken != nil || ptr.ToString(requests[2].Variables.NextToken) != "page-4" {
+		t.Errorf("unexpected next tokens: %v, %v", requests[0].Variables.NextToken, requests[2].Variables.NextToken)
+	}
+
+	if _, err := paginator.NextPage(context.Background()); err == nil {
+		t.Error("expected an error when no pages are left")
+	}
+}
@@ -0,0 +1,187 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+)
+
+type ListRequestsInput struct {
+	Status          *string // Only return requests with this status
+	AccountId       *string // Only return requests for this account
+	Email           *string // Only return requests made by the user with this email
+	StartTimeAfter  *string // Only return requests starting at or after this RFC 3339 time
+	StartTimeBefore *string // Only return requests starting at or before this RFC 3339 time
+	Limit           *int32
+	NextToken       *string
+}
+
+type ListRequestsOutput struct {
+	Requests  []*Request `json:"items"`
+	NextToken *string    `json:"nextToken"`
+}
+
+func (client *Client) ListRequests(ctx context.Context, in *ListRequestsInput) (*ListRequestsOutput, error) {
+	out := &struct {
+		List *ListRequestsOutput `json:"listRequestss"`
+	}{}
+
+	variables := map[string]interface{}{
+		"limit":     in.Limit,
+		"nextToken": in.NextToken,
+	}
+
+	filter := map[string]interface{}{}
+
+	if in.Status != nil {
+		filter["status"] = map[string]interface{}{"eq": in.Status}
+	}
+
+	if in.AccountId != nil {
+		filter["accountId"] = map[string]interface{}{"eq": in.AccountId}
+	}
+
+	if in.Email != nil {
+		filter["email"] = map[string]interface{}{"eq": in.Email}
+	}
+
+	// Start times are stored as ISO 8601 strings in UTC, so they can be compared as strings.
+	startTime := map[string]interface{}{}
+
+	if in.StartTimeAfter != nil {
+		startTime["ge"] = in.StartTimeAfter
+	}
+
+	if in.StartTimeBefore != nil {
+		startTime["le"] = in.StartTimeBefore
+	}
+
+	if len(startTime) > 0 {
+		filter["startTime"] = startTime
+	}
+
+	if len(filter) > 0 {
+		variables["filter"] = filter
+	}
+
+	q := `query ListRequestss($filter: ModelRequestsFilterInput, $limit: Int, $nextToken: String) {
+		listRequestss(filter: $filter, limit: $limit, nextToken: $nextToken) {
+			items {
+				id
+				email
+				username
+				accountId
+				accountName
+				role
+				roleId
+				startTime
+				endTime
+				duration
+				justification
+				ticketNo
+				status
+				comment
+				approver
+				approverId
+				approvers
+				approver_ids
+				revoker
+				revokerId
+				revokeComment
+				createdAt
+				updatedAt
+			}
+			nextToken
+		}
+	}`
+
+	err := client.exec(ctx, q, variables, out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.List == nil {
+		return &ListRequestsOutput{}, nil
+	}
+
+	return out.List, nil
+}
+
+type ListRequestsPaginatorOptions struct {
+	// The maximum number of requests to evaluate per page. Filters are applied after the limit, so
+	// a page can hold fewer items than this.
+	Limit int32
+
+	// Stop paginating if the service returns the same next token twice in a row.
+	StopOnDuplicateToken bool
+}
+
+// A ListRequestsPaginator pages through the results of ListRequests.
+type ListRequestsPaginator struct {
+	options   ListRequestsPaginatorOptions
+	client    *Client
+	params    *ListRequestsInput
+	nextToken *string
+	firstPage bool
+}
+
+func NewListRequestsPaginator(client *Client, params *ListRequestsInput, optFns ...func(*ListRequestsPaginatorOptions)) *ListRequestsPaginator {
+	if params == nil {
+		params = &ListRequestsInput{}
+	}
+
+	options := ListRequestsPaginatorOptions{}
+
+	if params.Limit != nil {
+		options.Limit = *params.Limit
+	}
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	return &ListRequestsPaginator{
+		options:   options,
+		client:    client,
+		params:    params,
+		nextToken: params.NextToken,
+		firstPage: true,
+	}
+}
+
+// HasMorePages returns true if there are more pages to retrieve.
+func (p *ListRequestsPaginator) HasMorePages() bool {
+	return p.firstPage || (p.nextToken != nil && len(*p.nextToken) != 0)
+}
+
+// NextPage retrieves the next ListRequests page.
+func (p *ListRequestsPaginator) NextPage(ctx context.Context) (*ListRequestsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, errors.New("no more pages available")
+	}
+
+	params := *p.params
+	params.NextToken = p.nextToken
+
+	if p.options.Limit > 0 {
+		limit := p.options.Limit
+		params.Limit = &limit
+	}
+
+	result, err := p.client.ListRequests(ctx, &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.firstPage = false
+
+	prevToken := p.nextToken
+	p.nextToken = result.NextToken
+
+	if p.options.StopOnDuplicateToken && prevToken != nil && p.nextToken != nil && *prevToken == *p.nextToken {
+		p.nextToken = nil
+	}
+
+	return result, nil
+}
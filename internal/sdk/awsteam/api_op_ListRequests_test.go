@@ -0,0 +1,77 @@
+package awsteam
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func TestListRequestsPaginator(t *testing.T) {
+	items := []map[string]interface{}{}
+	for i := 0; i < 3; i++ {
+		items = append(items, map[string]interface{}{
+			"id":            fmt.Sprintf("request-%d", i),
+			"email":         "jane@contoso.com",
+			"accountId":     "123456789012",
+			"role":          "AdministratorAccess",
+			"startTime":     fmt.Sprintf("2024-05-0%dT09:00:00.000Z", i+1),
+			"duration":      "2",
+			"justification": "Investigating an incident",
+			"status":        "ended",
+			"approvers":     []string{"team-admins"},
+		})
+	}
+
+	var requests []listRequest
+	client := newTestClient(newPagedServer(t, "listRequestss", items, 2, &requests))
+
+	paginator := NewListRequestsPaginator(client, &ListRequestsInput{
+		Status:          ptr.String("ended"),
+		AccountId:       ptr.String("123456789012"),
+		Email:           ptr.String("jane@contoso.com"),
+		StartTimeAfter:  ptr.String("2024-05-01T00:00:00Z"),
+		StartTimeBefore: ptr.String("2024-06-01T00:00:00Z"),
+	})
+
+	var results []*Request
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		results = append(results, page.Requests...)
+	}
+
+	if len(results) != len(items) {
+		t.Fatalf("expected %d requests, got %d", len(items), len(results))
+	}
+
+	if ptr.ToString(results[1].StartTime) != "2024-05-02T09:00:00.000Z" || ptr.ToString(results[1].Approvers[0]) != "team-admins" {
+		t.Errorf("unexpected request: %+v", results[1])
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+
+	filter := requests[0].Variables.Filter
+	expected := map[string]map[string]string{
+		"status":    {"eq": "ended"},
+		"accountId": {"eq": "123456789012"},
+		"email":     {"eq": "jane@contoso.com"},
+		"startTime": {"ge": "2024-05-01T00:00:00Z", "le": "2024-06-01T00:00:00Z"},
+	}
+
+	for field, conditions := range expected {
+		for op, value := range conditions {
+			if filter[field][op] != value {
+				t.Errorf("expected filter %s.%s to be %q, got %q", field, op, value, filter[field][op])
+			}
+		}
+	}
+}
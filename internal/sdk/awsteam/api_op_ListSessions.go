@@ -0,0 +1,152 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+)
+
+type ListSessionsInput struct {
+	StartTimeAfter *string // Only return sessions starting at or after this RFC 3339 time
+	EndTimeBefore  *string // Only return sessions ending at or before this RFC 3339 time
+	Limit          *int32
+	NextToken      *string
+}
+
+type ListSessionsOutput struct {
+	Sessions  []*Session `json:"items"`
+	NextToken *string    `json:"nextToken"`
+}
+
+func (client *Client) ListSessions(ctx context.Context, in *ListSessionsInput) (*ListSessionsOutput, error) {
+	out := &struct {
+		List *ListSessionsOutput `json:"listSessionss"`
+	}{}
+
+	variables := map[string]interface{}{
+		"limit":     in.Limit,
+		"nextToken": in.NextToken,
+	}
+
+	// Session times are stored as ISO 8601 strings in UTC, so they can be compared as strings.
+	filter := map[string]interface{}{}
+
+	if in.StartTimeAfter != nil {
+		filter["startTime"] = map[string]interface{}{"ge": in.StartTimeAfter}
+	}
+
+	if in.EndTimeBefore != nil {
+		filter["endTime"] = map[string]interface{}{"le": in.EndTimeBefore}
+	}
+
+	if len(filter) > 0 {
+		variables["filter"] = filter
+	}
+
+	q := `query ListSessionss($filter: ModelSessionsFilterInput, $limit: Int, $nextToken: String) {
+		listSessionss(filter: $filter, limit: $limit, nextToken: $nextToken) {
+			items {
+				id
+				username
+				accountId
+				role
+				startTime
+				endTime
+				approver_ids
+				queryId
+				expireAt
+			}
+			nextToken
+		}
+	}`
+
+	err := client.exec(ctx, q, variables, out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.List == nil {
+		return &ListSessionsOutput{}, nil
+	}
+
+	return out.List, nil
+}
+
+type ListSessionsPaginatorOptions struct {
+	// The maximum number of sessions to evaluate per page. Filters are applied after the limit, so
+	// a page can hold fewer items than this.
+	Limit int32
+
+	// Stop paginating if the service returns the same next token twice in a row.
+	StopOnDuplicateToken bool
+}
+
+// A ListSessionsPaginator pages through the results of ListSessions.
+type ListSessionsPaginator struct {
+	options   ListSessionsPaginatorOptions
+	client    *Client
+	params    *ListSessionsInput
+	nextToken *string
+	firstPage bool
+}
+
+func NewListSessionsPaginator(client *Client, params *ListSessionsInput, optFns ...func(*ListSessionsPaginatorOptions)) *ListSessionsPaginator {
+	if params == nil {
+		params = &ListSessionsInput{}
+	}
+
+	options := ListSessionsPaginatorOptions{}
+
+	if params.Limit != nil {
+		options.Limit = *params.Limit
+	}
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	return &ListSessionsPaginator{
+		options:   options,
+		client:    client,
+		params:    params,
+		nextToken: params.NextToken,
+		firstPage: true,
+	}
+}
+
+// HasMorePages returns true if there are more pages to retrieve.
+func (p *ListSessionsPaginator) HasMorePages() bool {
+	return p.firstPage || (p.nextToken != nil && len(*p.nextToken) != 0)
+}
+
+// NextPage retrieves the next ListSessions page.
+func (p *ListSessionsPaginator) NextPage(ctx context.Context) (*ListSessionsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, errors.New("no more pages available")
+	}
+
+	params := *p.params
+	params.NextToken = p.nextToken
+
+	if p.options.Limit > 0 {
+		limit := p.options.Limit
+		params.Limit = &limit
+	}
+
+	result, err := p.client.ListSessions(ctx, &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.firstPage = false
+
+	prevToken := p.nextToken
+	p.nextToken = result.NextToken
+
+	if p.options.StopOnDuplicateToken && prevToken != nil && p.nextToken != nil && *prevToken == *p.nextToken {
+		p.nextToken = nil
+	}
+
+	return result, nil
+}
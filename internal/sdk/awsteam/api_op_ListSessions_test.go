@@ -0,0 +1,63 @@
+package awsteam
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func TestListSessionsPaginator(t *testing.T) {
+	items := []map[string]interface{}{}
+	for i := 0; i < 3; i++ {
+		items = append(items, map[string]interface{}{
+			"id":           fmt.Sprintf("request-%d", i),
+			"username":     "jane",
+			"accountId":    "123456789012",
+			"role":         "AdministratorAccess",
+			"startTime":    fmt.Sprintf("2024-05-0%dT09:00:00.000Z", i+1),
+			"endTime":      fmt.Sprintf("2024-05-0%dT11:00:00.000Z", i+1),
+			"approver_ids": []string{"approver-id"},
+			"expireAt":     1717232400,
+		})
+	}
+
+	var requests []listRequest
+	client := newTestClient(newPagedServer(t, "listSessionss", items, 2, &requests))
+
+	paginator := NewListSessionsPaginator(client, &ListSessionsInput{
+		StartTimeAfter: ptr.String("2024-05-01T00:00:00.000Z"),
+		EndTimeBefore:  ptr.String("2024-06-01T00:00:00.000Z"),
+	})
+
+	var sessions []*Session
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		sessions = append(sessions, page.Sessions...)
+	}
+
+	if len(sessions) != len(items) {
+		t.Fatalf("expected %d sessions, got %d", len(items), len(sessions))
+	}
+
+	if ptr.ToString(sessions[2].EndTime) != "2024-05-03T11:00:00.000Z" || ptr.ToInt64(sessions[2].ExpireAt) != 1717232400 {
+		t.Errorf("unexpected session: %+v", sessions[2])
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+
+	for i, req := range requests {
+		if req.Variables.Filter["startTime"]["ge"] != "2024-05-01T00:00:00.000Z" || req.Variables.Filter["endTime"]["le"] != "2024-06-01T00:00:00.000Z" {
+			t.Errorf("request %d: unexpected filter %v", i, req.Variables.Filter)
+		}
+	}
+}
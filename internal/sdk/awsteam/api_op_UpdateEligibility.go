@@ -2,7 +2,6 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 )
 
@@ -68,13 +67,7 @@ func (client *Client) UpdateEligibility(ctx context.Context, in *UpdateEligibili
 		}
 	}`
 
-	raw, err := client.GraphClient.ExecRaw(ctx, q, variables)
-
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(raw, out)
+	err := client.exec(ctx, q, variables, out)
 
 	if err != nil {
 		return nil, err
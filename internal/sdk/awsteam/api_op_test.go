@@ -0,0 +1,221 @@
+package awsteam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+// Strings that would break or inject into a query built with string interpolation.
+var hostileStrings = []string{
+	`ticket "quoted"`,
+	`back\slash\`,
+	`"}) { deleteSettings(input: { id: "settings" }) { id } } #`,
+	"multi\nline\ttabbed",
+	`$input ${injected} %s %d`,
+	`unicode ✓ "`,
+}
+
+var operationFieldRegexp = regexp.MustCompile(`{\s*(\w+)\(`)
+
+type graphRequest struct {
+	Query     string                     `json:"query"`
+	Variables map[string]json.RawMessage `json:"variables"`
+}
+
+// newEchoServer starts a GraphQL stand-in that echoes the `input` or `id` variable back as the
+// operation result. It fails the test if any hostile string made it into the query text.
+func newEchoServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := graphRequest{}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		for _, s := range hostileStrings {
+			if strings.Contains(req.Query, s) {
+				t.Errorf("query text contains interpolated value %q", s)
+			}
+		}
+
+		match := operationFieldRegexp.FindStringSubmatch(req.Query)
+		if match == nil {
+			t.Errorf("unable to find operation field in query: %s", req.Query)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var result json.RawMessage
+		if input, ok := req.Variables["input"]; ok {
+			result = input
+		} else if id, ok := req.Variables["id"]; ok {
+			result = json.RawMessage(`{"id":` + string(id) + `}`)
+		} else {
+			t.Errorf("operation %s sent no variables", match[1])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]json.RawMessage{match[1]: result},
+		})
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestOperations_hostileStrings(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(newEchoServer(t))
+
+	for _, s := range hostileStrings {
+		s := s
+
+		t.Run(s, func(t *testing.T) {
+			approvers := []*string{ptr.String(s)}
+
+			createApprovers, err := client.CreateApprovers(ctx, &CreateApproversInput{Id: ptr.String(s), Name: ptr.String(s), Approvers: approvers, GroupIds: approvers, TicketNo: ptr.String(s)})
+			assertRoundTrip(t, "CreateApprovers", err, s, func() []*string {
+				a := createApprovers.Approvers
+				return []*string{a.Id, a.Name, a.Approvers[0], a.GroupIds[0], a.TicketNo}
+			})
+
+			updateApprovers, err := client.UpdateApprovers(ctx, &UpdateApproversInput{Id: ptr.String(s), Name: ptr.String(s), Approvers: approvers, GroupIds: approvers, TicketNo: ptr.String(s)})
+			assertRoundTrip(t, "UpdateApprovers", err, s, func() []*string {
+				a := updateApprovers.Approvers
+				return []*string{a.Id, a.Name, a.Approvers[0], a.GroupIds[0], a.TicketNo}
+			})
+
+			getApprovers, err := client.GetApprovers(ctx, &GetApproversInput{Id: ptr.String(s)})
+			assertRoundTrip(t, "GetApprovers", err, s, func() []*string {
+				return []*string{getApprovers.Approvers.Id}
+			})
+
+			deleteApprovers, err := client.DeleteApprovers(ctx, &DeleteApproversInput{Id: ptr.String(s)})
+			assertRoundTrip(t, "DeleteApprovers", err, s, func() []*string {
+				return []*string{deleteApprovers.Approvers.Id}
+			})
+
+			accounts := []*EligibilityAccount{{Id: ptr.String(s), Name: ptr.String(s)}}
+			permissions := []*EligibilityPermission{{Id: ptr.String(s), Name: ptr.String(s)}}
+
+			createEligibility, err := client.CreateEligibility(ctx, &CreateEligibilityInput{Id: ptr.String(s), Name: ptr.String(s), Accounts: accounts, Permissions: permissions, TicketNo: ptr.String(s), Duration: ptr.Int64(1)})
+			assertRoundTrip(t, "CreateEligibility", err, s, func() []*string {
+				e := createEligibility.Eligibility
+				return []*string{e.Id, e.Name, e.Accounts[0].Name, e.Permissions[0].Id, e.TicketNo}
+			})
+
+			updateEligibility, err := client.UpdateEligibility(ctx, &UpdateEligibilityInput{Id: ptr.String(s), Name: ptr.String(s), Accounts: accounts, Permissions: permissions, TicketNo: ptr.String(s)})
+			assertRoundTrip(t, "UpdateEligibility", err, s, func() []*string {
+				e := updateEligibility.Eligibility
+				return []*string{e.Id, e.Name, e.Accounts[0].Name, e.Permissions[0].Id, e.TicketNo}
+			})
+
+			getEligibility, err := client.GetEligibility(ctx, &GetEligibilityInput{Id: ptr.String(s)})
+			assertRoundTrip(t, "GetEligibility", err, s, func() []*string {
+				return []*string{getEligibility.Eligibility.Id}
+			})
+
+			deleteEligibility, err := client.DeleteEligibility(ctx, &DeleteEligibilityInput{Id: ptr.String(s)})
+			assertRoundTrip(t, "DeleteEligibility", err, s, func() []*string {
+				return []*string{deleteEligibility.Eligibility.Id}
+			})
+
+			createSettings, err := client.CreateSettings(ctx, &CreateSettingsInput{Id: ptr.String(s), Duration: ptr.Int64(1), Expiry: ptr.Int64(1), SlackToken: ptr.String(s), TeamAdminGroup: ptr.String(s), ModifiedBy: ptr.String(s)})
+			assertRoundTrip(t, "CreateSettings", err, s, func() []*string {
+				o := createSettings.Settings
+				return []*string{o.Id, o.SlackToken, o.TeamAdminGroup, o.ModifiedBy}
+			})
+
+			updateSettings, err := client.UpdateSettings(ctx, &UpdateSettingsInput{Id: ptr.String(s), Duration: ptr.Int64(1), Expiry: ptr.Int64(1), SlackToken: ptr.String(s), SesSourceEmail: ptr.String(s), TeamAuditorGroup: ptr.String(s)})
+			assertRoundTrip(t, "UpdateSettings", err, s, func() []*string {
+				o := updateSettings.Settings
+				return []*string{o.Id, o.SlackToken, o.SesSourceEmail, o.TeamAuditorGroup}
+			})
+
+			getSettings, err := client.GetSettings(ctx, &GetSettingsInput{Id: ptr.String(s)})
+			assertRoundTrip(t, "GetSettings", err, s, func() []*string {
+				return []*string{getSettings.Settings.Id}
+			})
+
+			deleteSettings, err := client.DeleteSettings(ctx, &DeleteSettingsInput{Id: ptr.String(s)})
+			assertRoundTrip(t, "DeleteSettings", err, s, func() []*string {
+				return []*string{deleteSettings.Settings.Id}
+			})
+		})
+	}
+}
+
+func TestOperations_defaultSettingsId(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(newEchoServer(t))
+
+	in := &GetSettingsInput{}
+
+	out, err := client.GetSettings(ctx, in)
+	if err != nil {
+		t.Fatalf("GetSettings: unexpected error: %s", err)
+	}
+
+	if got := ptr.ToString(out.Settings.Id); got != "settings" {
+		t.Errorf("GetSettings: expected id %q, got %q", "settings", got)
+	}
+
+	if in.Id != nil {
+		t.Errorf("GetSettings: expected the caller's input to be left untouched, got id %q", ptr.ToString(in.Id))
+	}
+
+	createIn := &CreateSettingsInput{Duration: ptr.Int64(1), Expiry: ptr.Int64(1)}
+	if _, err := client.CreateSettings(ctx, createIn); err != nil {
+		t.Fatalf("CreateSettings: unexpected error: %s", err)
+	}
+
+	if createIn.Id != nil {
+		t.Errorf("CreateSettings: expected the caller's input to be left untouched, got id %q", ptr.ToString(createIn.Id))
+	}
+
+	updateIn := &UpdateSettingsInput{Duration: ptr.Int64(1), Expiry: ptr.Int64(1)}
+	if _, err := client.UpdateSettings(ctx, updateIn); err != nil {
+		t.Fatalf("UpdateSettings: unexpected error: %s", err)
+	}
+
+	if updateIn.Id != nil {
+		t.Errorf("UpdateSettings: expected the caller's input to be left untouched, got id %q", ptr.ToString(updateIn.Id))
+	}
+
+	deleteIn := &DeleteSettingsInput{}
+	if _, err := client.DeleteSettings(ctx, deleteIn); err != nil {
+		t.Fatalf("DeleteSettings: unexpected error: %s", err)
+	}
+
+	if deleteIn.Id != nil {
+		t.Errorf("DeleteSettings: expected the caller's input to be left untouched, got id %q", ptr.ToString(deleteIn.Id))
+	}
+}
+
+func assertRoundTrip(t *testing.T, op string, err error, expected string, values func() []*string) {
+	t.Helper()
+
+	if err != nil {
+		t.Errorf("%s: unexpected error: %s", op, err)
+		return
+	}
+
+	for i, v := range values() {
+		if got := ptr.ToString(v); got != expected {
+			t.Errorf("%s: value %d: expected %q, got %q", op, i, expected, got)
+		}
+	}
+}
@@ -1,11 +1,112 @@
 package awsteam
 
 import (
-	"github.com/hasura/go-graphql-client"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
 )
 
 type Client struct {
 	GraphEndpoint string
-	GraphClient   *graphql.Client
+	HTTPClient    *http.Client
 	Config        *Config
+	Retryer       *Retryer
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage   `json:"data"`
+	Errors []graphQLErrorRaw `json:"errors"`
+}
+
+type graphQLErrorRaw struct {
+	ErrorType string        `json:"errorType"`
+	Message   string        `json:"message"`
+	Path      []interface{} `json:"path"`
+}
+
+// exec sends the query to the graph endpoint and unmarshals the response data into out, retrying
+// failures the Retryer considers transient. Errors returned in the response are converted into the
+// error types defined in errors.go.
+func (client *Client) exec(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+	payload, err := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+
+	if err != nil {
+		return err
+	}
+
+	retryer := client.Retryer
+
+	if retryer == nil {
+		retryer = NewRetryer()
+	}
+
+	mutation := strings.HasPrefix(strings.TrimSpace(query), "mutation")
+
+	return retryer.do(ctx, mutation, func() error {
+		return client.send(ctx, payload, out)
+	})
+}
+
+// send makes a single request to the graph endpoint.
+func (client *Client) send(ctx context.Context, payload []byte, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.GraphEndpoint, bytes.NewReader(payload))
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := client.HTTPClient.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+
+	if err != nil {
+		return err
+	}
+
+	// Throttling and server errors are reported by status so they can be retried.
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError {
+		return newHTTPStatusError(res.StatusCode, body)
+	}
+
+	resp := &graphQLResponse{}
+	decodeErr := json.Unmarshal(body, resp)
+
+	if res.StatusCode != http.StatusOK {
+		if decodeErr == nil && len(resp.Errors) > 0 {
+			return newGraphQLErrors(resp.Errors)
+		}
+
+		return newHTTPStatusError(res.StatusCode, body)
+	}
+
+	if decodeErr != nil {
+		return fmt.Errorf("decoding graph response: %w", decodeErr)
+	}
+
+	if len(resp.Errors) > 0 {
+		return newGraphQLErrors(resp.Errors)
+	}
+
+	if len(resp.Data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(resp.Data, out)
 }
@@ -3,66 +3,305 @@ package awsteam
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hasura/go-graphql-client"
-	"golang.org/x/oauth2"
 )
 
+const (
+	// Sends the client id and secret in the body of the token request.
+	TokenAuthMethodPost = "post"
+
+	// Sends the client id and secret in a basic Authorization header of the token request.
+	TokenAuthMethodBasic = "basic"
+)
+
+const (
+	// Fetches tokens from the token endpoint with the client credentials grant.
+	AuthModeClientCredentials = "client_credentials"
+
+	// Uses a static access token.
+	AuthModeAccessToken = "access_token"
+
+	// Runs a local command that prints a token to stdout.
+	AuthModeTokenCommand = "token_command"
+
+	// Signs requests with AWS Signature Version 4 using IAM credentials.
+	AuthModeIAM = "iam"
+
+	// Sends an AppSync API key with each request.
+	AuthModeAPIKey = "api_key"
+)
+
+// The scopes requested when Config.Scopes is not set.
+var DefaultScopes = []string{"api/admin"}
+
 // The Oath2 token.
 type Token struct {
 	AccessToken string `json:"access_token"`
 	ExpiresIn   int    `json:"expires_in"`
 	TokenType   string `json:"token_type"`
+
+	// The time the token expires, calculated from ExpiresIn when the token is received.
+	Expiry time.Time `json:"-"`
 }
 
 // A Config provides service configuration for service clients.
 type Config struct {
+	// An AppSync API key sent in the x-api-key header instead of a Bearer token.
+	APIKey string
+
+	// A static access token used for Bearer Authentication instead of fetching one from the token
+	// endpoint.
+	AccessToken string
+
+	// The authentication mode, one of AuthModeClientCredentials, AuthModeAccessToken,
+	// AuthModeTokenCommand, AuthModeIAM or AuthModeAPIKey. Defaults to the mode selected by the fields that are set.
+	AuthMode string
+
 	// The Oath2 client id
 	ClientId string
 
 	// The Oath2 client secret
 	ClientSecret string
 
-	// The Graph Client the SDK's API clients will use to invoke Graph requests.
-	GraphClient *graphql.Client
-
 	// The graph endpoint where aws team is deployed
 	GraphEndpoint string
 
 	// The HTTPClient the SDK's API clients will use to invoke Graph requests.
 	HTTPClient *http.Client
 
-	// The Oath2 token to be used for Bearer Authentication
-	Token *Token
+	// The AWS settings used to sign requests when AuthMode is AuthModeIAM.
+	IAM *IAMConfig
+
+	// Determines how failed requests are retried. Defaults to NewRetryer when not set.
+	Retryer *Retryer
+
+	// The scopes requested with the token. Defaults to DefaultScopes when not set.
+	Scopes []string
+
+	// How the client credentials are sent to the token endpoint, either TokenAuthMethodPost or
+	// TokenAuthMethodBasic. Defaults to TokenAuthMethodPost when not set.
+	TokenAuthMethod string
+
+	// A command and its arguments that print a JSON token to stdout, used instead of fetching one
+	// from the token endpoint.
+	TokenCommand []string
 
 	// The Oath2 endpoint for getting a token
 	TokenEndpoint string
+
+	// Caches the Oath2 token and refreshes it before it expires
+	tokenSource *tokenSource
+
+	// Signs requests when AuthMode is AuthModeIAM
+	signer *iamSigner
+
+	// The authentication mode selected by Build
+	mode string
 }
 
-func (config *Config) Build(ctx context.Context) {
+// Build validates the authentication settings and fetches the initial token, or the AWS credentials
+// in IAM mode. Nothing is fetched in API key mode. The returned error will be one of AuthConfigError, InvalidEndpointError,
+// EndpointUnreachableError, InvalidClientError, UnexpectedStatusError, TokenCommandError, MalformedTokenError or
+// AWSCredentialsError.
+func (config *Config) Build(ctx context.Context) error {
 	// Configure the AWS TEAM client
-	// First we need to get a token from the oath endpoint
-	authPayload := strings.NewReader(fmt.Sprintf(`grant_type=client_credentials&scope=api%%2Fadmin&client_id=%s&client_secret=%s`, config.ClientId, config.ClientSecret))
+	// First we need to get a token using the configured authentication mode
+	authMode, err := config.authMode()
+
+	if err != nil {
+		return err
+	}
+
+	switch authMode {
+	case AuthModeAPIKey:
+		config.HTTPClient = &http.Client{}
+	case AuthModeIAM:
+		signer, err := newIAMSigner(ctx, config)
+
+		if err != nil {
+			return err
+		}
+
+		config.HTTPClient = &http.Client{}
+		config.signer = signer
+	default:
+		config.tokenSource = newTokenSource(config, authMode)
+
+		if _, err := config.tokenSource.Token(ctx); err != nil {
+			return err
+		}
+
+		// Initiate clients
+		config.HTTPClient = &http.Client{}
+	}
+
+	config.mode = authMode
+
+	return nil
+}
+
+// Token returns the Oauth2 token used for Bearer Authentication, fetching a new one when the cached
+// token is about to expire. It returns nil in IAM and API key mode.
+func (config *Config) Token(ctx context.Context) (*Token, error) {
+	if config.tokenSource == nil {
+		return nil, nil
+	}
+
+	return config.tokenSource.Token(ctx)
+}
+
+func (config *Config) NewClient(ctx context.Context) (*Client, error) {
+	switch config.mode {
+	case AuthModeAPIKey:
+		// Returns a configured client that sends the API key with each request
+		config.HTTPClient = &http.Client{
+			Transport: &apiKeyTransport{
+				apiKey: config.APIKey,
+				base:   http.DefaultTransport,
+			},
+		}
+	case AuthModeIAM:
+		// Returns a configured client that signs each request
+		config.HTTPClient = &http.Client{
+			Transport: &iamTransport{
+				signer: config.signer,
+				base:   http.DefaultTransport,
+			},
+		}
+	case "":
+		return nil, errors.New("Config must be built before creating a client.")
+	default:
+		// Returns a configured client that refreshes its token as it expires
+		config.HTTPClient = &http.Client{
+			Transport: &tokenTransport{
+				source: config.tokenSource,
+				base:   http.DefaultTransport,
+			},
+		}
+	}
+
+	if config.Retryer == nil {
+		config.Retryer = NewRetryer()
+	}
+
+	client := &Client{
+		Config:        config,
+		GraphEndpoint: config.GraphEndpoint,
+		HTTPClient:    config.HTTPClient,
+		Retryer:       config.Retryer,
+	}
+
+	return client, nil
+}
+
+// authMode returns the configured authentication mode, or the mode selected by the fields that are
+// set when AuthMode is empty. It returns an AuthConfigError when settings for more than one mode are
+// set or they do not match AuthMode.
+func (config *Config) authMode() (string, error) {
+	var modes []string
+
+	if config.APIKey != "" {
+		modes = append(modes, AuthModeAPIKey)
+	}
+
+	if config.AccessToken != "" {
+		modes = append(modes, AuthModeAccessToken)
+	}
+
+	if len(config.TokenCommand) > 0 {
+		modes = append(modes, AuthModeTokenCommand)
+	}
+
+	if config.ClientId != "" || config.ClientSecret != "" {
+		modes = append(modes, AuthModeClientCredentials)
+	}
+
+	switch config.AuthMode {
+	case "":
+	case AuthModeClientCredentials, AuthModeAccessToken, AuthModeTokenCommand, AuthModeIAM, AuthModeAPIKey:
+		for _, mode := range modes {
+			if mode != config.AuthMode {
+				return "", &AuthConfigError{Reason: fmt.Sprintf("%s can not be configured when the auth mode is %s", mode, config.AuthMode)}
+			}
+		}
+
+		if (config.AuthMode == AuthModeAccessToken || config.AuthMode == AuthModeTokenCommand || config.AuthMode == AuthModeAPIKey) && len(modes) == 0 {
+			return "", &AuthConfigError{Reason: fmt.Sprintf("%s must be configured when the auth mode is %s", config.AuthMode, config.AuthMode)}
+		}
+
+		return config.AuthMode, nil
+	default:
+		return "", &AuthConfigError{Reason: fmt.Sprintf("unknown auth mode %q", config.AuthMode)}
+	}
+
+	switch len(modes) {
+	case 0:
+		return AuthModeClientCredentials, nil
+	case 1:
+		return modes[0], nil
+	default:
+		return "", &AuthConfigError{Reason: fmt.Sprintf("only one of %s can be configured", strings.Join(modes, ", "))}
+	}
+}
+
+// An apiKeyTransport adds the AppSync API key to each request.
+type apiKeyTransport struct {
+	apiKey string
+	base   http.RoundTripper
+}
+
+func (t *apiKeyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	authorized := req.Clone(req.Context())
+	authorized.Header.Set("x-api-key", t.apiKey)
+
+	return t.base.RoundTrip(authorized)
+}
+
+// fetchToken requests a new token from the token endpoint using the client credentials grant.
+func (config *Config) fetchToken(ctx context.Context) (*Token, error) {
+	scopes := config.Scopes
+
+	if len(scopes) == 0 {
+		scopes = DefaultScopes
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("scope", strings.Join(scopes, " "))
+
+	if config.TokenAuthMethod != TokenAuthMethodBasic {
+		form.Set("client_id", config.ClientId)
+		form.Set("client_secret", config.ClientSecret)
+	}
 
-	tflog.Debug(ctx, "Preparing token request", map[string]interface{}{"token_endpoint": config.TokenEndpoint, "graph_endpoint": config.GraphEndpoint, "client_id": config.ClientId})
+	tflog.Debug(ctx, "Preparing token request", map[string]interface{}{"token_endpoint": config.TokenEndpoint, "graph_endpoint": config.GraphEndpoint, "client_id": config.ClientId, "scopes": scopes})
 	authClient := &http.Client{}
-	authReq, err := http.NewRequest("POST", config.TokenEndpoint, authPayload)
+	authReq, err := http.NewRequestWithContext(ctx, "POST", config.TokenEndpoint, strings.NewReader(form.Encode()))
 
 	if err != nil {
-		panic(err)
+		tflog.Error(ctx, "Data provided is invalid. Unable to build request for token endpoint.")
+		return nil, &InvalidEndpointError{Endpoint: config.TokenEndpoint, Err: err}
 	}
 
 	authReq.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	if config.TokenAuthMethod == TokenAuthMethodBasic {
+		authReq.SetBasicAuth(config.ClientId, config.ClientSecret)
+	}
+
 	res, err := authClient.Do(authReq)
 
 	if err != nil {
-		tflog.Error(ctx, "Data provided is invalid. Unable to build request for token endpoint.")
-		panic(err)
+		tflog.Error(ctx, "Unable to send request to token endpoint.")
+		return nil, &EndpointUnreachableError{Endpoint: config.TokenEndpoint, Err: err}
 	}
 
 	defer res.Body.Close()
@@ -71,7 +310,12 @@ func (config *Config) Build(ctx context.Context) {
 
 	if err != nil {
 		tflog.Error(ctx, "Failed to receive token from endpoint.")
-		panic(err)
+		return nil, &EndpointUnreachableError{Endpoint: config.TokenEndpoint, Err: err}
+	}
+
+	if res.StatusCode != http.StatusOK {
+		tflog.Error(ctx, "Token endpoint returned an error.", map[string]interface{}{"status_code": res.StatusCode})
+		return nil, tokenResponseError(config.TokenEndpoint, res.StatusCode, body)
 	}
 
 	token := &Token{}
@@ -80,29 +324,44 @@ func (config *Config) Build(ctx context.Context) {
 
 	if err != nil {
 		tflog.Error(ctx, "Invalid JSON in response. Unmarshalling failed.")
-		panic(err)
+		return nil, &MalformedTokenError{Reason: "response is not valid JSON", Err: err}
+	}
+
+	if token.AccessToken == "" {
+		tflog.Error(ctx, "Token response did not contain an access token.")
+		return nil, &MalformedTokenError{Reason: "response does not contain an access_token"}
 	}
 
-	// Initiate clients and save token
-	config.GraphClient = &graphql.Client{}
-	config.HTTPClient = &http.Client{}
-	config.Token = token
+	return token, nil
 }
 
-func (config *Config) NewClient(ctx context.Context) *Client {
-	// Returns a configured client
-	src := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: config.Token.AccessToken},
-	)
+// tokenResponseError converts an unsuccessful token endpoint response into an InvalidClientError or UnexpectedStatusError.
+func tokenResponseError(endpoint string, statusCode int, body []byte) error {
+	oauthErr := struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}{}
 
-	config.HTTPClient = oauth2.NewClient(ctx, src)
-	config.GraphClient = graphql.NewClient(config.GraphEndpoint, config.HTTPClient)
+	// The body is only used to add detail, so a response that is not JSON is not an error in itself.
+	_ = json.Unmarshal(body, &oauthErr)
 
-	client := &Client{
-		Config:        config,
-		GraphClient:   config.GraphClient,
-		GraphEndpoint: config.GraphEndpoint,
+	if statusCode == http.StatusUnauthorized || oauthErr.Error == "invalid_client" || oauthErr.Error == "unauthorized_client" {
+		return &InvalidClientError{
+			StatusCode:  statusCode,
+			Code:        oauthErr.Error,
+			Description: oauthErr.ErrorDescription,
+		}
 	}
 
-	return client
+	const maxBodyLength = 512
+
+	if len(body) > maxBodyLength {
+		body = body[:maxBodyLength]
+	}
+
+	return &UnexpectedStatusError{
+		Endpoint:   endpoint,
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
 }
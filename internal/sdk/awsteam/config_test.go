@@ -0,0 +1,306 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConfigBuild_errors(t *testing.T) {
+	testCases := map[string]struct {
+		status int
+		body   string
+		check  func(t *testing.T, err error)
+	}{
+		"invalid client": {
+			status: http.StatusBadRequest,
+			body:   `{"error":"invalid_client","error_description":"Client authentication failed"}`,
+			check: func(t *testing.T, err error) {
+				var target *InvalidClientError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected InvalidClientError, got %T: %s", err, err)
+				}
+
+				if target.Code != "invalid_client" {
+					t.Errorf("expected code invalid_client, got %q", target.Code)
+				}
+			},
+		},
+		"unauthorized": {
+			status: http.StatusUnauthorized,
+			body:   `Unauthorized`,
+			check: func(t *testing.T, err error) {
+				var target *InvalidClientError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected InvalidClientError, got %T: %s", err, err)
+				}
+			},
+		},
+		"unexpected status": {
+			status: http.StatusInternalServerError,
+			body:   `<html>Internal Server Error</html>`,
+			check: func(t *testing.T, err error) {
+				var target *UnexpectedStatusError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected UnexpectedStatusError, got %T: %s", err, err)
+				}
+
+				if target.StatusCode != http.StatusInternalServerError {
+					t.Errorf("expected status 500, got %d", target.StatusCode)
+				}
+			},
+		},
+		"non JSON": {
+			status: http.StatusOK,
+			body:   `<html>login</html>`,
+			check: func(t *testing.T, err error) {
+				var target *MalformedTokenError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected MalformedTokenError, got %T: %s", err, err)
+				}
+			},
+		},
+		"empty access token": {
+			status: http.StatusOK,
+			body:   `{"access_token":"","expires_in":3600,"token_type":"Bearer"}`,
+			check: func(t *testing.T, err error) {
+				var target *MalformedTokenError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected MalformedTokenError, got %T: %s", err, err)
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			config := &Config{TokenEndpoint: server.URL}
+
+			tc.check(t, config.Build(context.Background()))
+		})
+	}
+}
+
+func TestConfigBuild_invalidEndpoint(t *testing.T) {
+	endpoint := "://not-a-url"
+
+	config := &Config{TokenEndpoint: endpoint}
+	err := config.Build(context.Background())
+
+	var target *InvalidEndpointError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected InvalidEndpointError, got %T: %s", err, err)
+	}
+
+	if target.Endpoint != endpoint {
+		t.Errorf("expected endpoint %q, got %q", endpoint, target.Endpoint)
+	}
+}
+
+func TestConfigBuild_endpointUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	endpoint := server.URL
+	server.Close()
+
+	config := &Config{TokenEndpoint: endpoint}
+	err := config.Build(context.Background())
+
+	var target *EndpointUnreachableError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected EndpointUnreachableError, got %T: %s", err, err)
+	}
+
+	if target.Endpoint != endpoint {
+		t.Errorf("expected endpoint %q, got %q", endpoint, target.Endpoint)
+	}
+}
+
+func TestConfigBuild_accessToken(t *testing.T) {
+	var authorization string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"data":{"getSettings":{"id":"settings"}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{AccessToken: "static-token", GraphEndpoint: server.URL}
+
+	if err := config.Build(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client, err := config.NewClient(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := client.GetSettings(context.Background(), &GetSettingsInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if authorization != "Bearer static-token" {
+		t.Errorf("expected the static token to be sent, got %q", authorization)
+	}
+}
+
+func TestConfigBuild_apiKey(t *testing.T) {
+	var apiKey, authorization string
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request to the token endpoint")
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer tokenServer.Close()
+
+	graphServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKey = r.Header.Get("x-api-key")
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"data":{"getSettings":{"id":"settings"}}}`))
+	}))
+	defer graphServer.Close()
+
+	config := &Config{APIKey: "da2-exampleapikey", GraphEndpoint: graphServer.URL, TokenEndpoint: tokenServer.URL}
+
+	if err := config.Build(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client, err := config.NewClient(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := client.GetSettings(context.Background(), &GetSettingsInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if apiKey != "da2-exampleapikey" {
+		t.Errorf("expected the API key to be sent, got %q", apiKey)
+	}
+
+	if authorization != "" {
+		t.Errorf("unexpected Authorization header %q", authorization)
+	}
+}
+
+func TestConfigBuild_conflictingAuthModes(t *testing.T) {
+	testCases := map[string]Config{
+		"access token and client credentials": {AccessToken: "token", ClientId: "id", ClientSecret: "secret"},
+		"access token and token command":      {AccessToken: "token", TokenCommand: []string{"broker"}},
+		"token command and client secret":     {TokenCommand: []string{"broker"}, ClientSecret: "secret"},
+		"api key and client credentials":      {APIKey: "key", ClientId: "id", ClientSecret: "secret"},
+		"api key mode without an api key":     {AuthMode: AuthModeAPIKey},
+	}
+
+	for name, config := range testCases {
+		config := config
+
+		t.Run(name, func(t *testing.T) {
+			err := config.Build(context.Background())
+
+			var target *AuthConfigError
+			if !errors.As(err, &target) {
+				t.Fatalf("expected AuthConfigError, got %T: %s", err, err)
+			}
+		})
+	}
+}
+
+func TestConfigNewClient_notBuilt(t *testing.T) {
+	config := &Config{}
+
+	if _, err := config.NewClient(context.Background()); err == nil {
+		t.Fatal("expected an error creating a client from a config that was not built")
+	}
+}
+
+func TestConfigBuild_tokenRequest(t *testing.T) {
+	const clientId = "client&id"
+	const clientSecret = "s3cr&t+=/%"
+
+	testCases := map[string]struct {
+		config         Config
+		expectedScope  string
+		expectedInBody bool
+	}{
+		"default": {
+			config:         Config{},
+			expectedScope:  "api/admin",
+			expectedInBody: true,
+		},
+		"post": {
+			config:         Config{Scopes: []string{"team/admin", "team/read"}, TokenAuthMethod: TokenAuthMethodPost},
+			expectedScope:  "team/admin team/read",
+			expectedInBody: true,
+		},
+		"basic": {
+			config:        Config{Scopes: []string{"team/admin"}, TokenAuthMethod: TokenAuthMethodBasic},
+			expectedScope: "team/admin",
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("parsing form: %s", err)
+				}
+
+				if got := r.PostForm.Get("grant_type"); got != "client_credentials" {
+					t.Errorf("expected grant_type client_credentials, got %q", got)
+				}
+
+				if got := r.PostForm.Get("scope"); got != tc.expectedScope {
+					t.Errorf("expected scope %q, got %q", tc.expectedScope, got)
+				}
+
+				username, password, hasBasicAuth := r.BasicAuth()
+
+				if tc.expectedInBody {
+					if hasBasicAuth {
+						t.Error("unexpected basic Authorization header")
+					}
+
+					if r.PostForm.Get("client_id") != clientId || r.PostForm.Get("client_secret") != clientSecret {
+						t.Errorf("unexpected client credentials in body: %v", r.PostForm)
+					}
+				} else {
+					if !hasBasicAuth || username != clientId || password != clientSecret {
+						t.Errorf("unexpected basic Authorization header: %q", r.Header.Get("Authorization"))
+					}
+
+					if r.PostForm.Has("client_id") || r.PostForm.Has("client_secret") {
+						t.Errorf("unexpected client credentials in body: %v", r.PostForm)
+					}
+				}
+
+				_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3600,"token_type":"Bearer"}`))
+			}))
+			defer server.Close()
+
+			config := tc.config
+			config.ClientId = clientId
+			config.ClientSecret = clientSecret
+			config.TokenEndpoint = server.URL
+
+			if err := config.Build(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package awsteam
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Matches the day and time parts of an ISO 8601 duration. Years and months are not supported as
+// they do not have a fixed length.
+var iso8601DurationRegexp = regexache.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseISO8601Duration parses durations such as "PT1H", "PT8H30M" or "P1DT12H".
+func parseISO8601Duration(s string) (time.Duration, error) {
+	match := iso8601DurationRegexp.FindStringSubmatch(s)
+
+	if match == nil || s == "P" || s[len(s)-1] == 'T' {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+	}
+
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var duration time.Duration
+
+	for i, unit := range units {
+		if match[i+1] == "" {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(match[i+1], 64)
+
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q: %w", s, err)
+		}
+
+		duration += time.Duration(value * float64(unit))
+	}
+
+	return duration, nil
+}
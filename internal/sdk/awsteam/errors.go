@@ -0,0 +1,262 @@
+package awsteam
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+// An EndpointUnreachableError is returned when a request can not be sent to the token endpoint.
+type EndpointUnreachableError struct {
+	Endpoint string
+	Err      error
+}
+
+func (e *EndpointUnreachableError) Error() string {
+	return fmt.Sprintf("unable to reach token endpoint %q: %s", e.Endpoint, e.Err)
+}
+
+func (e *EndpointUnreachableError) Unwrap() error {
+	return e.Err
+}
+
+// An InvalidEndpointError is returned when a request to the token endpoint can not be built, for
+// example because the endpoint is not a valid URL.
+type InvalidEndpointError struct {
+	Endpoint string
+	Err      error
+}
+
+func (e *InvalidEndpointError) Error() string {
+	return fmt.Sprintf("invalid token endpoint %q: %s", e.Endpoint, e.Err)
+}
+
+func (e *InvalidEndpointError) Unwrap() error {
+	return e.Err
+}
+
+// An InvalidClientError is returned when the token endpoint rejects the client id or client secret.
+type InvalidClientError struct {
+	StatusCode  int
+	Code        string
+	Description string
+}
+
+func (e *InvalidClientError) Error() string {
+	msg := fmt.Sprintf("token endpoint rejected the client credentials (status %d", e.StatusCode)
+
+	if e.Code != "" {
+		msg += ", error " + e.Code
+	}
+
+	msg += ")"
+
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+
+	return msg
+}
+
+// An UnexpectedStatusError is returned when the token endpoint responds with a status other than 200.
+type UnexpectedStatusError struct {
+	Endpoint   string
+	StatusCode int
+	Body       string
+}
+
+func (e *UnexpectedStatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d from token endpoint %q: %s", e.StatusCode, e.Endpoint, e.Body)
+}
+
+// A MalformedTokenError is returned when the token endpoint response can not be used as a token.
+type MalformedTokenError struct {
+	Reason string
+	Err    error
+}
+
+func (e *MalformedTokenError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("malformed token response: %s: %s", e.Reason, e.Err)
+	}
+
+	return fmt.Sprintf("malformed token response: %s", e.Reason)
+}
+
+func (e *MalformedTokenError) Unwrap() error {
+	return e.Err
+}
+
+// An AuthConfigError is returned when the authentication settings of a Config are missing or conflict.
+type AuthConfigError struct {
+	Reason string
+}
+
+func (e *AuthConfigError) Error() string {
+	return fmt.Sprintf("invalid authentication configuration: %s", e.Reason)
+}
+
+// A TokenCommandError is returned when the token command can not be run or exits with an error.
+type TokenCommandError struct {
+	Command string
+	Stderr  string
+	Err     error
+}
+
+func (e *TokenCommandError) Error() string {
+	msg := fmt.Sprintf("token command %q failed: %s", e.Command, e.Err)
+
+	if e.Stderr != "" {
+		msg += ": " + e.Stderr
+	}
+
+	return msg
+}
+
+func (e *TokenCommandError) Unwrap() error {
+	return e.Err
+}
+
+// An AWSCredentialsError is returned when the AWS credentials used to sign requests can not be loaded.
+type AWSCredentialsError struct {
+	Err error
+}
+
+func (e *AWSCredentialsError) Error() string {
+	return fmt.Sprintf("unable to load AWS credentials: %s", e.Err)
+}
+
+func (e *AWSCredentialsError) Unwrap() error {
+	return e.Err
+}
+
+// A GraphQLError is an entry of the errors array returned in a GraphQL response.
+type GraphQLError struct {
+	ErrorType string
+	Message   string
+	Path      []string
+}
+
+func (e *GraphQLError) Error() string {
+	msg := e.Message
+
+	if e.ErrorType != "" {
+		msg = e.ErrorType + ": " + msg
+	}
+
+	if len(e.Path) > 0 {
+		msg += fmt.Sprintf(" (path: %s)", strings.Join(e.Path, "."))
+	}
+
+	return msg
+}
+
+// A NotFoundError is returned when the requested item does not exist.
+type NotFoundError struct {
+	GraphQLError
+}
+
+// An UnauthorizedError is returned when the caller is not allowed to perform the operation.
+type UnauthorizedError struct {
+	GraphQLError
+}
+
+// A ConditionalCheckFailedError is returned when a mutation's condition was not met, such as
+// creating an item that already exists.
+type ConditionalCheckFailedError struct {
+	GraphQLError
+}
+
+// A ValidationError is returned when the request was rejected as invalid.
+type ValidationError struct {
+	GraphQLError
+}
+
+// An HTTPStatusError is returned when the graph endpoint responds with a status other than 200
+// and no GraphQL errors.
+type HTTPStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d from graph endpoint: %s", e.StatusCode, e.Body)
+}
+
+func newNotFoundError(item string, id *string) *NotFoundError {
+	return &NotFoundError{GraphQLError{
+		ErrorType: "NotFound",
+		Message:   fmt.Sprintf("%s %q does not exist", item, ptr.ToString(id)),
+	}}
+}
+
+// notFoundOnConditionFailed converts a ConditionalCheckFailedError into a NotFoundError. Deletes are
+// conditional on the item existing, so a failed condition means there was nothing to delete.
+func notFoundOnConditionFailed(err error) error {
+	var conditionErr *ConditionalCheckFailedError
+
+	if errors.As(err, &conditionErr) {
+		return &NotFoundError{conditionErr.GraphQLError}
+	}
+
+	return err
+}
+
+func newHTTPStatusError(statusCode int, body []byte) error {
+	const maxBodyLength = 512
+
+	if len(body) > maxBodyLength {
+		body = body[:maxBodyLength]
+	}
+
+	if statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden {
+		return &UnauthorizedError{GraphQLError{
+			ErrorType: http.StatusText(statusCode),
+			Message:   string(body),
+		}}
+	}
+
+	return &HTTPStatusError{StatusCode: statusCode, Body: string(body)}
+}
+
+// newGraphQLErrors converts the errors of a GraphQL response into typed errors, joining them when there is more than one.
+func newGraphQLErrors(raw []graphQLErrorRaw) error {
+	errs := make([]error, 0, len(raw))
+
+	for _, r := range raw {
+		errs = append(errs, newGraphQLError(r))
+	}
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	return errors.Join(errs...)
+}
+
+func newGraphQLError(raw graphQLErrorRaw) error {
+	gqlErr := GraphQLError{
+		ErrorType: raw.ErrorType,
+		Message:   raw.Message,
+	}
+
+	for _, p := range raw.Path {
+		gqlErr.Path = append(gqlErr.Path, fmt.Sprint(p))
+	}
+
+	switch raw.ErrorType {
+	case "Unauthorized", "UnauthorizedException", "AccessDeniedException":
+		return &UnauthorizedError{gqlErr}
+	case "DynamoDB:ConditionalCheckFailedException", "ConditionalCheckFailedException":
+		return &ConditionalCheckFailedError{gqlErr}
+	case "NotFound", "NotFoundException", "ResourceNotFoundException":
+		return &NotFoundError{gqlErr}
+	case "ValidationError", "ValidationException", "DynamoDB:ValidationException", "BadRequestException":
+		return &ValidationError{gqlErr}
+	default:
+		return &gqlErr
+	}
+}
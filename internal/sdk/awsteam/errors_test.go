@@ -0,0 +1,121 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func TestExec_errorTypes(t *testing.T) {
+	testCases := map[string]struct {
+		status int
+		body   string
+		check  func(err error) bool
+	}{
+		"unauthorized": {
+			status: http.StatusOK,
+			body:   `{"data":{"getEligibility":null},"errors":[{"path":["getEligibility"],"errorType":"Unauthorized","message":"Not Authorized to access getEligibility on type Query"}]}`,
+			check: func(err error) bool {
+				var target *UnauthorizedError
+				return errors.As(err, &target) && target.Path[0] == "getEligibility"
+			},
+		},
+		"unauthorized status": {
+			status: http.StatusUnauthorized,
+			body:   `{"errors":[{"errorType":"UnauthorizedException","message":"You are not authorized to make this call."}]}`,
+			check: func(err error) bool {
+				var target *UnauthorizedError
+				return errors.As(err, &target)
+			},
+		},
+		"forbidden without errors": {
+			status: http.StatusForbidden,
+			body:   `Forbidden`,
+			check: func(err error) bool {
+				var target *UnauthorizedError
+				return errors.As(err, &target)
+			},
+		},
+		"conditional check failed": {
+			status: http.StatusOK,
+			body:   `{"data":{"getEligibility":null},"errors":[{"path":["getEligibility"],"errorType":"DynamoDB:ConditionalCheckFailedException","message":"The conditional request failed"}]}`,
+			check: func(err error) bool {
+				var target *ConditionalCheckFailedError
+				return errors.As(err, &target) && target.Message == "The conditional request failed"
+			},
+		},
+		"validation": {
+			status: http.StatusBadRequest,
+			body:   `{"errors":[{"errorType":"ValidationError","message":"Validation error of type FieldUndefined"}]}`,
+			check: func(err error) bool {
+				var target *ValidationError
+				return errors.As(err, &target)
+			},
+		},
+		"not found when null": {
+			status: http.StatusOK,
+			body:   `{"data":{"getEligibility":null}}`,
+			check: func(err error) bool {
+				var target *NotFoundError
+				return errors.As(err, &target)
+			},
+		},
+		"unknown error type": {
+			status: http.StatusOK,
+			body:   `{"data":null,"errors":[{"errorType":"Lambda:Unhandled","message":"boom"}]}`,
+			check: func(err error) bool {
+				var target *GraphQLError
+				return errors.As(err, &target) && target.ErrorType == "Lambda:Unhandled"
+			},
+		},
+		"multiple errors": {
+			status: http.StatusOK,
+			body:   `{"data":null,"errors":[{"errorType":"Lambda:Unhandled","message":"boom"},{"errorType":"Unauthorized","message":"denied"}]}`,
+			check: func(err error) bool {
+				var target *UnauthorizedError
+				return errors.As(err, &target)
+			},
+		},
+		"server error": {
+			status: http.StatusBadGateway,
+			body:   `<html>Bad Gateway</html>`,
+			check: func(err error) bool {
+				var target *HTTPStatusError
+				return errors.As(err, &target) && target.StatusCode == http.StatusBadGateway
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(newStaticServer(t, tc.status, tc.body))
+
+			_, err := client.GetEligibility(context.Background(), &GetEligibilityInput{Id: ptr.String("id")})
+
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if !tc.check(err) {
+				t.Errorf("unexpected error %T: %s", err, err)
+			}
+		})
+	}
+}
+
+func TestDelete_conditionFailedIsNotFound(t *testing.T) {
+	body := `{"data":{"deleteApprovers":null},"errors":[{"path":["deleteApprovers"],"errorType":"DynamoDB:ConditionalCheckFailedException","message":"The conditional request failed"}]}`
+	client := newTestClient(newStaticServer(t, http.StatusOK, body))
+
+	_, err := client.DeleteApprovers(context.Background(), &DeleteApproversInput{Id: ptr.String("123456789012")})
+
+	var target *NotFoundError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected NotFoundError, got %T: %s", err, err)
+	}
+}
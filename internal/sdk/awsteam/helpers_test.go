@@ -0,0 +1,50 @@
+package awsteam
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client for the server that retries without waiting.
+func newTestClient(server *httptest.Server) *Client {
+	return &Client{
+		GraphEndpoint: server.URL,
+		HTTPClient:    server.Client(),
+		Retryer: &Retryer{
+			MaxAttempts: DefaultMaxAttempts,
+			MaxBackoff:  time.Millisecond,
+		},
+	}
+}
+
+// newStaticServer returns a graph endpoint that responds to every request with the status and body.
+func newStaticServer(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// newFixtureClient returns a client for a graph endpoint that responds to every request with the
+// response in testdata/fixture.
+func newFixtureClient(t *testing.T, fixture string) *Client {
+	t.Helper()
+
+	body, err := os.ReadFile("testdata/" + fixture)
+
+	if err != nil {
+		t.Fatalf("reading fixture: %s", err)
+	}
+
+	return newTestClient(newStaticServer(t, http.StatusOK, string(body)))
+}
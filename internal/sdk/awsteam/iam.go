@@ -0,0 +1,228 @@
+package awsteam
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// The service name AppSync requests are signed for.
+const appSyncSigningName = "appsync"
+
+// Matches the region in a default AppSync endpoint, such as
+// https://example1234567890000.appsync-api.us-east-1.amazonaws.com/graphql
+var appSyncEndpointRegexp = regexache.MustCompile(`\.appsync-api\.([a-z0-9-]+)\.amazonaws\.com$`)
+
+// An IAMConfig holds the AWS settings used to sign requests in IAM mode. Settings that are not set
+// are loaded from the standard AWS environment variables and shared config files.
+type IAMConfig struct {
+	// The static access key. Used with SecretKey and SessionToken instead of the default credential chain.
+	AccessKey string
+
+	// A role to assume with the loaded credentials.
+	AssumeRole *AssumeRoleConfig
+
+	// The shared config profile to load credentials and region from.
+	Profile string
+
+	// The region of the AppSync API. Defaults to the region in the graph endpoint, or the region of
+	// the AWS config for custom domains.
+	Region string
+
+	// The static secret key.
+	SecretKey string
+
+	// The static session token.
+	SessionToken string
+}
+
+// An AssumeRoleConfig describes a role assumed before signing requests.
+type AssumeRoleConfig struct {
+	// The duration of the role session. Defaults to 15 minutes when not set.
+	Duration time.Duration
+
+	// The external id required by the role's trust policy.
+	ExternalId string
+
+	// The ARN of the role to assume.
+	RoleArn string
+
+	// The name of the role session.
+	SessionName string
+}
+
+// An iamSigner signs requests with AWS Signature Version 4 for AppSync.
+type iamSigner struct {
+	credentials aws.CredentialsProvider
+	region      string
+	signer      *v4.Signer
+	now         func() time.Time
+}
+
+// newIAMSigner loads the AWS credentials and region described by the config's IAM settings.
+func newIAMSigner(ctx context.Context, config *Config) (*iamSigner, error) {
+	iam := config.IAM
+
+	if iam == nil {
+		iam = &IAMConfig{}
+	}
+
+	var opts []func(*awsconfig.LoadOptions) error
+
+	if iam.Region != "" {
+		opts = append(opts, awsconfig.WithRegion(iam.Region))
+	}
+
+	if iam.Profile != "" {
+		opts = append(opts, awsconfig.WithSharedConfigProfile(iam.Profile))
+	}
+
+	if iam.AccessKey != "" || iam.SecretKey != "" {
+		opts = append(opts, awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(iam.AccessKey, iam.SecretKey, iam.SessionToken)))
+	}
+
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+
+	if err != nil {
+		return nil, &AWSCredentialsError{Err: err}
+	}
+
+	if iam.AssumeRole != nil {
+		assumeRole := iam.AssumeRole
+		provider := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(awsConfig), assumeRole.RoleArn, func(o *stscreds.AssumeRoleOptions) {
+			if assumeRole.Duration > 0 {
+				o.Duration = assumeRole.Duration
+			}
+
+			if assumeRole.ExternalId != "" {
+				o.ExternalID = aws.String(assumeRole.ExternalId)
+			}
+
+			if assumeRole.SessionName != "" {
+				o.RoleSessionName = assumeRole.SessionName
+			}
+		})
+
+		awsConfig.Credentials = aws.NewCredentialsCache(provider)
+	}
+
+	// The region in a default AppSync endpoint takes precedence over the ambient AWS region, which is
+	// only used for custom domains.
+	region := iam.Region
+
+	if region == "" {
+		region = endpointRegion(config.GraphEndpoint)
+	}
+
+	if region == "" {
+		region = awsConfig.Region
+	}
+
+	if region == "" {
+		return nil, &AuthConfigError{Reason: "a region is required to sign requests when it can not be read from the graph endpoint"}
+	}
+
+	if awsConfig.Credentials == nil {
+		return nil, &AWSCredentialsError{Err: errors.New("no credentials were found")}
+	}
+
+	// Retrieve the credentials once so that missing or invalid credentials are reported by Build.
+	if _, err := awsConfig.Credentials.Retrieve(ctx); err != nil {
+		return nil, &AWSCredentialsError{Err: err}
+	}
+
+	tflog.Debug(ctx, "Signing requests with AWS credentials", map[string]interface{}{"graph_endpoint": config.GraphEndpoint, "region": region})
+
+	return &iamSigner{
+		credentials: awsConfig.Credentials,
+		region:      region,
+		signer:      v4.NewSigner(),
+		now:         time.Now,
+	}, nil
+}
+
+// endpointRegion returns the region of a default AppSync graph endpoint, or an empty string for
+// custom domains.
+func endpointRegion(endpoint string) string {
+	u, err := url.Parse(endpoint)
+
+	if err != nil {
+		return ""
+	}
+
+	match := appSyncEndpointRegexp.FindStringSubmatch(u.Hostname())
+
+	if match == nil {
+		return ""
+	}
+
+	return match[1]
+}
+
+// sign adds the Signature Version 4 headers to the request, hashing the body which is read in full
+// and replaced.
+func (s *iamSigner) sign(req *http.Request) (*http.Request, error) {
+	signed := req.Clone(req.Context())
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		req.Body.Close()
+	}
+
+	signed.Body = io.NopCloser(bytes.NewReader(body))
+	signed.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	creds, err := s.credentials.Retrieve(req.Context())
+
+	if err != nil {
+		return nil, &AWSCredentialsError{Err: err}
+	}
+
+	hash := sha256.Sum256(body)
+
+	if err := s.signer.SignHTTP(req.Context(), creds, signed, hex.EncodeToString(hash[:]), appSyncSigningName, s.region, s.now()); err != nil {
+		return nil, err
+	}
+
+	return signed, nil
+}
+
+// An iamTransport signs each request with the signer's AWS credentials.
+type iamTransport struct {
+	signer *iamSigner
+	base   http.RoundTripper
+}
+
+func (t *iamTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	signed, err := t.signer.sign(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.base.RoundTrip(signed)
+}
@@ -0,0 +1,80 @@
+package awsteam
+
+import (
+	"github.com/aws/smithy-go/ptr"
+)
+
+// WalkOUs calls fn for each OU in the tree, depth first, along with its ancestors ordered from the
+// root down to its parent. Walking stops when fn returns false.
+func WalkOUs(ous []OU, fn func(ou *OU, ancestors []*OU) bool) {
+	walkOUs(ous, nil, fn)
+}
+
+func walkOUs(ous []OU, ancestors []*OU, fn func(ou *OU, ancestors []*OU) bool) bool {
+	for i := range ous {
+		ou := &ous[i]
+
+		if !fn(ou, ancestors) {
+			return false
+		}
+
+		// Copy the ancestors so callers can keep the slice they were given.
+		children := make([]*OU, len(ancestors), len(ancestors)+1)
+		copy(children, ancestors)
+
+		if !walkOUs(ou.Children, append(children, ou), fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FindOUById returns the OU with the given id, or nil when it is not in the tree.
+func FindOUById(ous []OU, id string) *OU {
+	var found *OU
+
+	WalkOUs(ous, func(ou *OU, _ []*OU) bool {
+		if ptr.ToString(ou.Id) == id {
+			found = ou
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// FindOUsByName returns every OU with the given name. OU names are only unique among siblings, so
+// more than one OU can match.
+func FindOUsByName(ous []OU, name string) []*OU {
+	found := []*OU{}
+
+	WalkOUs(ous, func(ou *OU, _ []*OU) bool {
+		if ptr.ToString(ou.Name) == name {
+			found = append(found, ou)
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// OUPath returns the OUs from the root down to and including the OU with the given id, or nil when
+// it is not in the tree.
+func OUPath(ous []OU, id string) []*OU {
+	var path []*OU
+
+	WalkOUs(ous, func(ou *OU, ancestors []*OU) bool {
+		if ptr.ToString(ou.Id) == id {
+			path = append(ancestors, ou)
+			return false
+		}
+
+		return true
+	})
+
+	return path
+}
@@ -0,0 +1,165 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	// The default number of times a request is attempted, including the first attempt.
+	DefaultMaxAttempts = 4
+
+	// The default maximum time to wait between attempts.
+	DefaultMaxBackoff = 20 * time.Second
+
+	// The delay before the first retry, doubled for each attempt after that.
+	baseBackoff = 250 * time.Millisecond
+)
+
+// Error types returned in GraphQL responses for throttled or transient DynamoDB and AppSync failures.
+var retryableErrorTypes = map[string]bool{
+	"DynamoDB:ProvisionedThroughputExceededException": true,
+	"DynamoDB:RequestLimitExceeded":                   true,
+	"DynamoDB:ThrottlingException":                    true,
+	"DynamoDB:InternalServerError":                    true,
+	"DynamoDB:ServiceUnavailable":                     true,
+	"DynamoDB:TransactionConflictException":           true,
+	"Lambda:TooManyRequestsException":                 true,
+	"ThrottlingException":                             true,
+	"TooManyRequestsException":                        true,
+	"InternalFailure":                                 true,
+	"ServiceUnavailableException":                     true,
+}
+
+// Error types returned in GraphQL responses for requests that were throttled before anything was
+// written, so mutations failing with them can be retried.
+var throttlingErrorTypes = map[string]bool{
+	"DynamoDB:ProvisionedThroughputExceededException": true,
+	"DynamoDB:RequestLimitExceeded":                   true,
+	"DynamoDB:ThrottlingException":                    true,
+	"Lambda:TooManyRequestsException":                 true,
+	"ThrottlingException":                             true,
+	"TooManyRequestsException":                        true,
+}
+
+// A Retryer decides whether a failed request is attempted again and how long to wait before doing so.
+type Retryer struct {
+	// The maximum number of times a request is attempted, including the first attempt.
+	MaxAttempts int
+
+	// The maximum time to wait between attempts.
+	MaxBackoff time.Duration
+
+	// Reports whether a query that failed with the error should be retried.
+	IsErrorRetryable func(error) bool
+
+	// Reports whether a mutation that failed with the error should be retried. Mutations are only
+	// retried when the error shows nothing was written, as retrying a write that went through fails or
+	// writes twice.
+	IsMutationErrorRetryable func(error) bool
+}
+
+// NewRetryer returns a Retryer using the default attempts, backoff and retryable errors.
+func NewRetryer() *Retryer {
+	return &Retryer{
+		MaxAttempts:              DefaultMaxAttempts,
+		MaxBackoff:               DefaultMaxBackoff,
+		IsErrorRetryable:         IsErrorRetryable,
+		IsMutationErrorRetryable: IsMutationErrorRetryable,
+	}
+}
+
+// IsErrorRetryable reports whether the error is caused by throttling, a server side failure or a
+// dropped connection, all of which may succeed when retried.
+func IsErrorRetryable(err error) bool {
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var gqlErr *GraphQLError
+	if errors.As(err, &gqlErr) {
+		return retryableErrorTypes[gqlErr.ErrorType]
+	}
+
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr)
+}
+
+// IsMutationErrorRetryable reports whether the error shows the request was rejected before anything
+// was written, because it was throttled or the connection was refused. Server errors and dropped
+// connections are not retryable as the write may already have been made.
+func IsMutationErrorRetryable(err error) bool {
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	var gqlErr *GraphQLError
+	if errors.As(err, &gqlErr) {
+		return throttlingErrorTypes[gqlErr.ErrorType]
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// backoff returns the time to wait before the given retry, using exponential backoff with full jitter.
+func (r *Retryer) backoff(retry int) time.Duration {
+	maxBackoff := r.MaxBackoff
+
+	if maxBackoff <= 0 {
+		return 0
+	}
+
+	delay := baseBackoff << retry
+
+	// Guard against overflowing the shift as well as exceeding the maximum.
+	if delay <= 0 || delay > maxBackoff {
+		delay = maxBackoff
+	}
+
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// do calls fn until it succeeds, returns an error that is not retryable or runs out of attempts.
+// Mutations are classified with IsMutationErrorRetryable instead of IsErrorRetryable.
+func (r *Retryer) do(ctx context.Context, mutation bool, fn func() error) error {
+	isRetryable := r.IsErrorRetryable
+
+	if isRetryable == nil {
+		isRetryable = IsErrorRetryable
+	}
+
+	if mutation {
+		isRetryable = r.IsMutationErrorRetryable
+
+		if isRetryable == nil {
+			isRetryable = IsMutationErrorRetryable
+		}
+	}
+
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		err = fn()
+
+		if err == nil || attempt+1 >= r.MaxAttempts || !isRetryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(r.backoff(attempt))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+// newFlakyServer returns a graph endpoint that responds with the failure for the first failures
+// requests and with a settings item after that.
+func newFlakyServer(t *testing.T, failures int32, status int, body string) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if atomic.AddInt32(&requests, 1) <= failures {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"getSettings":{"id":"settings"}}}`))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestRetryer_retriesTransientFailures(t *testing.T) {
+	testCases := map[string]struct {
+		status int
+		body   string
+	}{
+		"throttled": {
+			status: http.StatusTooManyRequests,
+			body:   `{"errors":[{"errorType":"TooManyRequestsException","message":"Rate exceeded"}]}`,
+		},
+		"server error": {
+			status: http.StatusServiceUnavailable,
+			body:   `Service Unavailable`,
+		},
+		"dynamodb throttling": {
+			status: http.StatusOK,
+			body:   `{"data":{"getSettings":null},"errors":[{"errorType":"DynamoDB:ProvisionedThroughputExceededException","message":"Throughput exceeded"}]}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			server, requests := newFlakyServer(t, 2, tc.status, tc.body)
+			client := newTestClient(server)
+
+			out, err := client.GetSettings(context.Background(), &GetSettingsInput{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if ptr.ToString(out.Settings.Id) != "settings" {
+				t.Errorf("unexpected settings: %+v", out.Settings)
+			}
+
+			if got := atomic.LoadInt32(requests); got != 3 {
+				t.Errorf("expected 3 requests, got %d", got)
+			}
+		})
+	}
+}
+
+func TestRetryer_stopsAfterMaxAttempts(t *testing.T) {
+	server, requests := newFlakyServer(t, 10, http.StatusBadGateway, `Bad Gateway`)
+	client := newTestClient(server)
+	client.Retryer.MaxAttempts = 3
+
+	_, err := client.GetSettings(context.Background(), &GetSettingsInput{})
+
+	var target *HTTPStatusError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected HTTPStatusError, got %T: %s", err, err)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestRetryer_doesNotRetryPermanentFailures(t *testing.T) {
+	body := `{"data":{"getSettings":null},"errors":[{"errorType":"Unauthorized","message":"Not Authorized to access getSettings on type Query"}]}`
+	server, requests := newFlakyServer(t, 10, http.StatusOK, body)
+	client := newTestClient(server)
+
+	_, err := client.GetSettings(context.Background(), &GetSettingsInput{})
+
+	var target *UnauthorizedError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected UnauthorizedError, got %T: %s", err, err)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestRetryer_doesNotRetryMutationsAfterServerErrors(t *testing.T) {
+	server, requests := newFlakyServer(t, 10, http.StatusInternalServerError, `Internal Server Error`)
+	client := newTestClient(server)
+
+	_, err := client.UpdateSettings(context.Background(), &UpdateSettingsInput{Duration: ptr.Int64(1), Expiry: ptr.Int64(1)})
+
+	var target *HTTPStatusError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected HTTPStatusError, got %T: %s", err, err)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestRetryer_retriesThrottledMutations(t *testing.T) {
+	body := `{"errors":[{"errorType":"TooManyRequestsException","message":"Rate exceeded"}]}`
+	server, requests := newFlakyServer(t, 2, http.StatusTooManyRequests, body)
+	client := newTestClient(server)
+
+	if _, err := client.UpdateSettings(context.Background(), &UpdateSettingsInput{Duration: ptr.Int64(1), Expiry: ptr.Int64(1)}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestIsMutationErrorRetryable(t *testing.T) {
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"throttled":          {err: &HTTPStatusError{StatusCode: http.StatusTooManyRequests}, expected: true},
+		"server error":       {err: &HTTPStatusError{StatusCode: http.StatusBadGateway}, expected: false},
+		"dynamodb throttled": {err: &GraphQLError{ErrorType: "DynamoDB:ThrottlingException"}, expected: true},
+		"dynamodb failure":   {err: &GraphQLError{ErrorType: "DynamoDB:InternalServerError"}, expected: false},
+		"connection refused": {err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, expected: true},
+		"connection reset":   {err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsMutationErrorRetryable(tc.err); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestRetryer_customClassifier(t *testing.T) {
+	body := `{"data":{"getSettings":null},"errors":[{"errorType":"Lambda:Unhandled","message":"Task timed out"}]}`
+	server, requests := newFlakyServer(t, 1, http.StatusOK, body)
+	client := newTestClient(server)
+	client.Retryer.IsErrorRetryable = func(err error) bool {
+		var gqlErr *GraphQLError
+		return errors.As(err, &gqlErr) && gqlErr.ErrorType == "Lambda:Unhandled"
+	}
+
+	if _, err := client.GetSettings(context.Background(), &GetSettingsInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestRetryer_stopsWhenContextIsDone(t *testing.T) {
+	server, requests := newFlakyServer(t, 1000, http.StatusServiceUnavailable, `Service Unavailable`)
+	client := newTestClient(server)
+	client.Retryer.MaxAttempts = 1000
+	client.Retryer.MaxBackoff = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	if _, err := client.GetSettings(ctx, &GetSettingsInput{}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected retries to stop with the context, took %s", elapsed)
+	}
+
+	if got := atomic.LoadInt32(requests); got >= 1000 {
+		t.Errorf("expected retries to stop with the context, got %d requests", got)
+	}
+}
+
+func TestRetryer_backoffIsBounded(t *testing.T) {
+	retryer := &Retryer{MaxBackoff: 2 * time.Second}
+
+	for retry := 0; retry < 100; retry++ {
+		if got := retryer.backoff(retry); got < 0 || got > retryer.MaxBackoff {
+			t.Fatalf("retry %d: backoff %s outside [0, %s]", retry, got, retryer.MaxBackoff)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package awsteam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// The output expected from the token command. Expiration takes precedence over ExpiresIn, and the
+// token is used until it is rejected when neither is set.
+type tokenCommandOutput struct {
+	AccessToken string     `json:"access_token"`
+	ExpiresIn   int        `json:"expires_in"`
+	TokenType   string     `json:"token_type"`
+	Expiration  *time.Time `json:"expiration"`
+}
+
+// staticToken returns the configured access token, which is used until it is rejected.
+func (config *Config) staticToken(ctx context.Context) (*Token, error) {
+	return &Token{AccessToken: config.AccessToken, TokenType: "Bearer"}, nil
+}
+
+// commandToken runs the token command and reads the token it prints to stdout.
+func (config *Config) commandToken(ctx context.Context) (*Token, error) {
+	command := strings.Join(config.TokenCommand, " ")
+
+	tflog.Debug(ctx, "Running token command", map[string]interface{}{"token_command": command})
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, config.TokenCommand[0], config.TokenCommand[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		tflog.Error(ctx, "Token command failed.")
+		return nil, &TokenCommandError{Command: command, Stderr: strings.TrimSpace(stderr.String()), Err: err}
+	}
+
+	out := &tokenCommandOutput{}
+
+	if err := json.Unmarshal(stdout.Bytes(), out); err != nil {
+		tflog.Error(ctx, "Invalid JSON in token command output. Unmarshalling failed.")
+		return nil, &MalformedTokenError{Reason: "token command output is not valid JSON", Err: err}
+	}
+
+	if out.AccessToken == "" {
+		tflog.Error(ctx, "Token command output did not contain an access token.")
+		return nil, &MalformedTokenError{Reason: "token command output does not contain an access_token"}
+	}
+
+	token := &Token{
+		AccessToken: out.AccessToken,
+		ExpiresIn:   out.ExpiresIn,
+		TokenType:   out.TokenType,
+	}
+
+	if out.Expiration != nil {
+		token.Expiry = *out.Expiration
+	}
+
+	return token, nil
+}
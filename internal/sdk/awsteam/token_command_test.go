@@ -0,0 +1,107 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestTokenCommandHelper is not a real test. It is run as the token command by the tests below and
+// prints the value of AWSTEAM_TEST_TOKEN_COMMAND_OUTPUT, exiting with an error when it is empty.
+func TestTokenCommandHelper(t *testing.T) {
+	output, ok := os.LookupEnv("AWSTEAM_TEST_TOKEN_COMMAND_OUTPUT")
+
+	if !ok {
+		return
+	}
+
+	if output == "" {
+		fmt.Fprint(os.Stderr, "vault broker unavailable")
+		os.Exit(1)
+	}
+
+	fmt.Print(output)
+	os.Exit(0)
+}
+
+func tokenCommand(t *testing.T, output string) []string {
+	t.Setenv("AWSTEAM_TEST_TOKEN_COMMAND_OUTPUT", output)
+
+	return []string{os.Args[0], "-test.run=^TestTokenCommandHelper$"}
+}
+
+func TestConfigBuild_tokenCommand(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	config := &Config{TokenCommand: tokenCommand(t, fmt.Sprintf(`{"access_token":"command-token","expiration":%q}`, expiration.Format(time.RFC3339)))}
+
+	if err := config.Build(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	token, err := config.Token(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if token.AccessToken != "command-token" {
+		t.Errorf("expected access token %q, got %q", "command-token", token.AccessToken)
+	}
+
+	if !token.Expiry.Equal(expiration) {
+		t.Errorf("expected expiry %s, got %s", expiration, token.Expiry)
+	}
+}
+
+func TestConfigBuild_tokenCommandErrors(t *testing.T) {
+	testCases := map[string]struct {
+		output string
+		check  func(t *testing.T, err error)
+	}{
+		"command failed": {
+			output: "",
+			check: func(t *testing.T, err error) {
+				var target *TokenCommandError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected TokenCommandError, got %T: %s", err, err)
+				}
+
+				if target.Stderr != "vault broker unavailable" {
+					t.Errorf("expected stderr to be captured, got %q", target.Stderr)
+				}
+			},
+		},
+		"non JSON": {
+			output: "not a token",
+			check: func(t *testing.T, err error) {
+				var target *MalformedTokenError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected MalformedTokenError, got %T: %s", err, err)
+				}
+			},
+		},
+		"empty access token": {
+			output: `{"expires_in":3600}`,
+			check: func(t *testing.T, err error) {
+				var target *MalformedTokenError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected MalformedTokenError, got %T: %s", err, err)
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			config := &Config{TokenCommand: tokenCommand(t, tc.output)}
+
+			tc.check(t, config.Build(context.Background()))
+		})
+	}
+}
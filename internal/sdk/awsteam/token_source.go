@@ -0,0 +1,128 @@
+package awsteam
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// How long before a token expires that a new one will be requested.
+const defaultTokenRefreshWindow = 60 * time.Second
+
+// A tokenSource caches the token and fetches a new one before it expires.
+type tokenSource struct {
+	fetch         func(ctx context.Context) (*Token, error)
+	refreshWindow time.Duration
+	now           func() time.Time
+
+	mu    sync.Mutex
+	token *Token
+}
+
+func newTokenSource(config *Config, authMode string) *tokenSource {
+	fetch := config.fetchToken
+
+	switch authMode {
+	case AuthModeAccessToken:
+		fetch = config.staticToken
+	case AuthModeTokenCommand:
+		fetch = config.commandToken
+	}
+
+	return &tokenSource{
+		fetch:         fetch,
+		refreshWindow: defaultTokenRefreshWindow,
+		now:           time.Now,
+	}
+}
+
+// Token returns the cached token, fetching a new one when there is none or it is about to expire.
+// Tokens without an expiry are kept until they are invalidated.
+func (s *tokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && (s.token.Expiry.IsZero() || s.now().Add(s.refreshWindow).Before(s.token.Expiry)) {
+		return s.token, nil
+	}
+
+	token, err := s.fetch(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if token.Expiry.IsZero() && token.ExpiresIn > 0 {
+		token.Expiry = s.now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	s.token = token
+
+	return token, nil
+}
+
+// invalidate drops the cached token if it is still the given token, forcing the next call to Token to fetch a new one.
+func (s *tokenSource) invalidate(token *Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == token {
+		s.token = nil
+	}
+}
+
+// A tokenTransport adds the Bearer token to each request and retries once with a new token after a 401.
+type tokenTransport struct {
+	source *tokenSource
+	base   http.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token(req.Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.base.RoundTrip(authorizeRequest(req, token))
+
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+
+	// The body has already been consumed so the request can only be replayed if it can be rebuilt.
+	if req.Body != nil && req.GetBody == nil {
+		return res, nil
+	}
+
+	retry := req.Clone(req.Context())
+
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+
+		if err != nil {
+			return res, nil
+		}
+	}
+
+	_, _ = io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+
+	t.source.invalidate(token)
+	token, err = t.source.Token(req.Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.base.RoundTrip(authorizeRequest(retry, token))
+}
+
+func authorizeRequest(req *http.Request, token *Token) *http.Request {
+	authorized := req.Clone(req.Context())
+	authorized.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	return authorized
+}
@@ -0,0 +1,228 @@
+package awsteam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// tokenServer is a stand-in for the Cognito token endpoint and the TEAM graph endpoint. Tokens are
+// only accepted by the graph endpoint until they expire or are revoked.
+type tokenServer struct {
+	now       func() time.Time
+	expiresIn int
+
+	mu       sync.Mutex
+	issued   int
+	rejected int
+	expiry   map[string]time.Time
+
+	tokenEndpoint *httptest.Server
+	graphEndpoint *httptest.Server
+}
+
+func newTokenServer(t *testing.T, now func() time.Time, expiresIn int) *tokenServer {
+	t.Helper()
+
+	s := &tokenServer{
+		now:       now,
+		expiresIn: expiresIn,
+		expiry:    map[string]time.Time{},
+	}
+
+	s.tokenEndpoint = httptest.NewServer(http.HandlerFunc(s.handleToken))
+	s.graphEndpoint = httptest.NewServer(http.HandlerFunc(s.handleGraph))
+
+	t.Cleanup(s.tokenEndpoint.Close)
+	t.Cleanup(s.graphEndpoint.Close)
+
+	return s
+}
+
+func (s *tokenServer) handleToken(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.issued++
+	accessToken := fmt.Sprintf("token-%d", s.issued)
+	s.expiry[accessToken] = s.now().Add(time.Duration(s.expiresIn) * time.Second)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(Token{AccessToken: accessToken, ExpiresIn: s.expiresIn, TokenType: "Bearer"})
+}
+
+func (s *tokenServer) handleGraph(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	expiry, ok := s.expiry[accessToken]
+
+	if !ok || !s.now().Before(expiry) {
+		s.rejected++
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errors":[{"errorType":"UnauthorizedException","message":"Token has expired."}]}`))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"data":{"getSettings":{"id":"settings"}}}`))
+}
+
+// revokeAll invalidates every issued token without the client knowing.
+func (s *tokenServer) revokeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expiry = map[string]time.Time{}
+}
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func newTokenTestClient(t *testing.T, clock *fakeClock, server *tokenServer) *Client {
+	t.Helper()
+
+	config := &Config{
+		ClientId:      "client",
+		ClientSecret:  "secret",
+		GraphEndpoint: server.graphEndpoint.URL,
+		TokenEndpoint: server.tokenEndpoint.URL,
+	}
+
+	if err := config.Build(context.Background()); err != nil {
+		t.Fatalf("building config: %s", err)
+	}
+
+	config.tokenSource.now = clock.Now
+
+	client, err := config.NewClient(context.Background())
+
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	return client
+}
+
+func TestTokenSource_refreshesBeforeExpiry(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	server := newTokenServer(t, clock.Now, 300)
+	client := newTokenTestClient(t, clock, server)
+
+	token, err := client.Config.Token(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Build stamped the first token with the real clock, so refresh it against the fake one.
+	client.Config.tokenSource.invalidate(token)
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.GetSettings(ctx, &GetSettingsInput{}); err != nil {
+			t.Fatalf("request %d: unexpected error: %s", i, err)
+		}
+
+		if token, _ := client.Config.Token(ctx); token.AccessToken != fmt.Sprintf("token-%d", server.issued) {
+			t.Errorf("request %d: expected the config to return the refreshed token, got %q", i, token.AccessToken)
+		}
+
+		// The token in use is still valid for another 50 seconds, which is inside the refresh window.
+		clock.Advance(250 * time.Second)
+	}
+
+	if server.rejected != 0 {
+		t.Errorf("expected no requests to be rejected, got %d", server.rejected)
+	}
+
+	// One token from Build plus a fresh one for each request.
+	if server.issued != 6 {
+		t.Errorf("expected tokens to be refreshed before expiry, got %d issued", server.issued)
+	}
+}
+
+func TestTokenSource_reusesValidToken(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	server := newTokenServer(t, clock.Now, 3600)
+	client := newTokenTestClient(t, clock, server)
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.GetSettings(ctx, &GetSettingsInput{}); err != nil {
+			t.Fatalf("request %d: unexpected error: %s", i, err)
+		}
+	}
+
+	if server.issued != 1 {
+		t.Errorf("expected a single token to be issued, got %d", server.issued)
+	}
+}
+
+func TestTokenTransport_retriesOnceAfterUnauthorized(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	server := newTokenServer(t, clock.Now, 3600)
+	client := newTokenTestClient(t, clock, server)
+
+	server.revokeAll()
+
+	out, err := client.GetSettings(ctx, &GetSettingsInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if out.Settings == nil {
+		t.Fatal("expected settings in response")
+	}
+
+	if server.rejected != 1 {
+		t.Errorf("expected one rejected request, got %d", server.rejected)
+	}
+
+	if server.issued != 2 {
+		t.Errorf("expected a new token to be issued after the 401, got %d issued", server.issued)
+	}
+}
+
+func TestTokenTransport_givesUpAfterSecondUnauthorized(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	// Tokens expire the moment they are issued so every request is rejected.
+	server := newTokenServer(t, clock.Now, 0)
+	client := newTokenTestClient(t, clock, server)
+
+	_, err := client.GetSettings(ctx, &GetSettingsInput{})
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if server.rejected != 2 {
+		t.Errorf("expected the request to be attempted twice, got %d", server.rejected)
+	}
+}
@@ -1,5 +1,9 @@
 package awsteam
 
+import (
+	"time"
+)
+
 type Account struct {
 	Id   *string `json:"id"`
 	Name *string `json:"name"`
@@ -47,6 +51,11 @@ type EligibilityPermission struct {
 	Name *string `json:"name"`
 }
 
+type Group struct {
+	Id          *string `json:"GroupId"`
+	DisplayName *string `json:"DisplayName"`
+}
+
 type OU struct {
 	Id       *string `json:"id"`
 	Arn      *string `json:"arn"`
@@ -55,9 +64,30 @@ type OU struct {
 }
 
 type Permission struct {
-	Name     *string
-	Arn      *string
-	Duration *string
+	Name     *string `json:"Name"`
+	Arn      *string `json:"Arn"`
+	Duration *string `json:"Duration"` // ISO 8601 session duration, such as "PT1H"
+}
+
+// SessionDuration returns the permission set's session duration, or 0 when it is not set.
+func (p *Permission) SessionDuration() (time.Duration, error) {
+	if p.Duration == nil || *p.Duration == "" {
+		return 0, nil
+	}
+
+	return parseISO8601Duration(*p.Duration)
+}
+
+type Session struct {
+	Id          *string   `json:"id"`       // Id of the request the session was started for
+	Username    *string   `json:"username"` // User name of the user that assumed the role
+	AccountId   *string   `json:"accountId"`
+	Role        *string   `json:"role"` // Name of the assumed permission set
+	StartTime   *string   `json:"startTime"`
+	EndTime     *string   `json:"endTime"`
+	ApproverIds []*string `json:"approver_ids"`
+	QueryId     *string   `json:"queryId"` // Id of the CloudTrail Lake query for the session's activity
+	ExpireAt    *int64    `json:"expireAt"`
 }
 
 type Settings struct {
@@ -79,3 +109,34 @@ type Settings struct {
 	CreatedAt                 *string `json:"createdAt"`
 	UpdatedAt                 *string `json:"updatedAt"`
 }
+
+type Request struct {
+	Id            *string   `json:"id"`
+	Email         *string   `json:"email"`     // Email of the requester
+	Username      *string   `json:"username"`  // User name of the requester
+	AccountId     *string   `json:"accountId"` // Id of the account access was requested for
+	AccountName   *string   `json:"accountName"`
+	Role          *string   `json:"role"`   // Name of the requested permission set
+	RoleId        *string   `json:"roleId"` // ARN of the requested permission set
+	StartTime     *string   `json:"startTime"`
+	EndTime       *string   `json:"endTime"`
+	Duration      *string   `json:"duration"` // Requested duration in hours
+	Justification *string   `json:"justification"`
+	TicketNo      *string   `json:"ticketNo"`
+	Status        *string   `json:"status"` // Such as "pending", "approved", "in progress", "ended" or "rejected"
+	Comment       *string   `json:"comment"`
+	Approver      *string   `json:"approver"`
+	ApproverId    *string   `json:"approverId"`
+	Approvers     []*string `json:"approvers"`
+	ApproverIds   []*string `json:"approver_ids"`
+	Revoker       *string   `json:"revoker"`
+	RevokerId     *string   `json:"revokerId"`
+	RevokeComment *string   `json:"revokeComment"`
+	CreatedAt     *string   `json:"createdAt"`
+	UpdatedAt     *string   `json:"updatedAt"`
+}
+
+type User struct {
+	Id       *string `json:"UserId"`
+	UserName *string `json:"UserName"`
+}